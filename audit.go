@@ -0,0 +1,162 @@
+package gsclient
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	//AuditOutcomeSuccess outcome of a request the API has accepted
+	AuditOutcomeSuccess = "success"
+	//AuditOutcomeFailure outcome of a request that failed or was rejected by the API
+	AuditOutcomeFailure = "failure"
+
+	redactedValue = "REDACTED"
+)
+
+//redactedKeys are JSON keys whose values never end up in the audit log
+var redactedKeys = map[string]bool{
+	"password":    true,
+	"secret_key":  true,
+	"access_key":  true,
+	"token":       true,
+	"api_token":   true,
+	"credentials": true,
+}
+
+//AuditEntry a single entry of the audit log, written for every create, update or delete request
+type AuditEntry struct {
+	Timestamp   time.Time       `json:"timestamp"`
+	Method      string          `json:"method"`
+	Path        string          `json:"path"`
+	ObjectUUID  string          `json:"object_uuid,omitempty"`
+	RequestUUID string          `json:"request_uuid,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	Outcome     string          `json:"outcome"`
+	StatusCode  int             `json:"status_code,omitempty"`
+	Error       string          `json:"error,omitempty"`
+	DurationMs  int64           `json:"duration_ms"`
+}
+
+//AuditSink receives an entry for every create, update or delete request sent by the client
+type AuditSink interface {
+	WriteAuditEntry(entry AuditEntry) error
+}
+
+//FileAuditSink appends audit entries to a file, one JSON object per line
+type FileAuditSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+//NewFileAuditSink opens a file for appending audit entries, the file is created if it doesn't exist
+func NewFileAuditSink(fileName string) (*FileAuditSink, error) {
+	file, err := os.OpenFile(fileName, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileAuditSink{file: file}, nil
+}
+
+//WriteAuditEntry appends an entry to the file
+func (s *FileAuditSink) WriteAuditEntry(entry AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(append(line, '\n'))
+	return err
+}
+
+//Close closes the file
+func (s *FileAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+//audit writes an audit entry for a request that has been sent. Failing to write it is logged,
+//but doesn't fail the request as the change has been made already.
+func (cfg *Config) audit(method, uri string, jsonBody []byte, start time.Time, statusCode int, response []byte, err error) {
+	entry := AuditEntry{
+		Timestamp:  start.UTC(),
+		Method:     method,
+		Path:       uri,
+		ObjectUUID: objectUUIDFromURI(uri),
+		Body:       redactJSON(jsonBody),
+		Outcome:    AuditOutcomeSuccess,
+		StatusCode: statusCode,
+		DurationMs: int64(time.Since(start) / time.Millisecond),
+	}
+	var created CreateResponse
+	if json.Unmarshal(response, &created) == nil {
+		entry.RequestUUID = created.RequestUUID
+		if created.ObjectUUID != "" {
+			entry.ObjectUUID = created.ObjectUUID
+		}
+	}
+	switch {
+	case err != nil:
+		entry.Outcome = AuditOutcomeFailure
+		entry.Error = err.Error()
+	case statusCode >= 300:
+		var requestError RequestError
+		json.Unmarshal(response, &requestError)
+		requestError.StatusCode = statusCode
+		entry.Outcome = AuditOutcomeFailure
+		entry.Error = requestError.Error()
+	}
+	if writeErr := cfg.AuditSink.WriteAuditEntry(entry); writeErr != nil {
+		cfg.logger.Errorf("Writing audit entry for %v %v has failed: %v", method, uri, writeErr)
+	}
+}
+
+//objectUUIDFromURI returns the last UUID in a URI, which is the object a request acts upon
+func objectUUIDFromURI(uri string) string {
+	segments := strings.Split(uri, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if isValidUUID(segments[i]) {
+			return segments[i]
+		}
+	}
+	return ""
+}
+
+//redactJSON replaces the values of secrets in a JSON document
+func redactJSON(data []byte) json.RawMessage {
+	if len(data) == 0 {
+		return nil
+	}
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil
+	}
+	redacted, err := json.Marshal(redactValue(document))
+	if err != nil {
+		return nil
+	}
+	return json.RawMessage(redacted)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range v {
+			if redactedKeys[strings.ToLower(key)] {
+				v[key] = redactedValue
+			} else {
+				v[key] = redactValue(inner)
+			}
+		}
+	case []interface{}:
+		for i, inner := range v {
+			v[i] = redactValue(inner)
+		}
+	}
+	return value
+}
@@ -0,0 +1,124 @@
+package gsclient
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryAuditSink struct {
+	entries []AuditEntry
+}
+
+func (s *memoryAuditSink) WriteAuditEntry(entry AuditEntry) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func TestClient_AuditSink(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	sink := &memoryAuditSink{}
+	client.cfg.AuditSink = sink
+	mux.HandleFunc(apiStorageBase, func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method == http.MethodGet {
+			fmt.Fprint(writer, prepareStorageListHTTPGet())
+			return
+		}
+		fmt.Fprint(writer, prepareStorageCreateResponse())
+	})
+	mux.HandleFunc(path.Join(apiStorageBase, dummyUUID), func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusConflict)
+		fmt.Fprint(writer, `{"status": "conflict", "message": "storage is still linked"}`)
+	})
+	mux.HandleFunc("/requests/", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprintf(writer, `{"%s": {"status":"done"}}`, dummyRequestUUID)
+	})
+
+	_, err := client.GetStorageList()
+	assert.Nil(t, err)
+	_, err = client.CreateStorage(StorageCreateRequest{
+		Capacity:     10,
+		LocationUUID: dummyUUID,
+		Name:         "test",
+		Template: &StorageTemplate{
+			TemplateUUID: dummyUUID,
+			Password:     "very secret",
+			PasswordType: "plain",
+		},
+	})
+	assert.Nil(t, err)
+	err = client.DeleteStorage(dummyUUID)
+	assert.Error(t, err)
+
+	//GET requests aren't audited
+	if assert.Equal(t, 2, len(sink.entries)) {
+		created := sink.entries[0]
+		assert.Equal(t, http.MethodPost, created.Method)
+		assert.Equal(t, apiStorageBase, created.Path)
+		assert.Equal(t, dummyUUID, created.ObjectUUID)
+		assert.Equal(t, dummyRequestUUID, created.RequestUUID)
+		assert.Equal(t, AuditOutcomeSuccess, created.Outcome)
+		assert.Equal(t, http.StatusOK, created.StatusCode)
+		assert.NotContains(t, string(created.Body), "very secret")
+		assert.Contains(t, string(created.Body), fmt.Sprintf(`"password":"%s"`, redactedValue))
+		assert.Contains(t, string(created.Body), `"password_type":"plain"`)
+
+		deleted := sink.entries[1]
+		assert.Equal(t, http.MethodDelete, deleted.Method)
+		assert.Equal(t, dummyUUID, deleted.ObjectUUID)
+		assert.Equal(t, AuditOutcomeFailure, deleted.Outcome)
+		assert.Equal(t, http.StatusConflict, deleted.StatusCode)
+		assert.Contains(t, deleted.Error, "storage is still linked")
+		assert.Nil(t, deleted.Body)
+	}
+}
+
+func TestFileAuditSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gsclient")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fileName := filepath.Join(dir, "audit.log")
+
+	for i := 0; i < 2; i++ {
+		sink, err := NewFileAuditSink(fileName)
+		if err != nil {
+			t.Fatalf("NewFileAuditSink returned an error %v", err)
+		}
+		err = sink.WriteAuditEntry(AuditEntry{Method: http.MethodDelete, Path: apiServerBase, Outcome: AuditOutcomeSuccess})
+		assert.Nil(t, err)
+		assert.Nil(t, sink.Close())
+	}
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	var lines int
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry AuditEntry
+		assert.Nil(t, json.Unmarshal(scanner.Bytes(), &entry))
+		assert.Equal(t, http.MethodDelete, entry.Method)
+		lines++
+	}
+	assert.Equal(t, 2, lines)
+}
+
+func TestObjectUUIDFromURI(t *testing.T) {
+	otherUUID := "11111111-2222-3333-4444-555555555555"
+	assert.Equal(t, "", objectUUIDFromURI(apiServerBase))
+	assert.Equal(t, dummyUUID, objectUUIDFromURI(path.Join(apiServerBase, dummyUUID, "power")))
+	assert.Equal(t, otherUUID, objectUUIDFromURI(path.Join(apiStorageBase, dummyUUID, "snapshots", otherUUID)))
+}
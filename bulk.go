@@ -0,0 +1,266 @@
+package gsclient
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//DefaultMaxConcurrency is the number of requests bulk operations send concurrently if Config.MaxConcurrency isn't set
+const DefaultMaxConcurrency = 10
+
+//BulkError errors of a bulk operation keyed by the UUID of the object that has failed
+type BulkError map[string]error
+
+//Error returns the errors as string, sorted by UUID
+func (e BulkError) Error() string {
+	ids := make([]string, 0, len(e))
+	for id := range e {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	messages := make([]string, 0, len(ids))
+	for _, id := range ids {
+		messages = append(messages, fmt.Sprintf("%s: %v", id, e[id]))
+	}
+	return fmt.Sprintf("%d of the objects have failed: %s", len(e), strings.Join(messages, "; "))
+}
+
+//uniqueIDs removes duplicates and empty strings from a list of UUIDs
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	var unique []string
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
+
+//forEachConcurrently calls fn for 0 <= i < n with a bounded number of concurrent calls.
+//The returned slice holds the error of each call.
+func (c *Client) forEachConcurrently(n int, fn func(i int) error) []error {
+	workers := c.cfg.MaxConcurrency
+	if workers <= 0 {
+		workers = DefaultMaxConcurrency
+	}
+	if workers > n {
+		workers = n
+	}
+	errs := make([]error, n)
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				errs[i] = fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+	return errs
+}
+
+//bulkError collects the errors of a bulk operation, it returns nil if there are none
+func bulkError(ids []string, errs []error) error {
+	failed := BulkError{}
+	for i, err := range errs {
+		if err != nil {
+			failed[ids[i]] = err
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return failed
+}
+
+//GetServersByUUID gets servers concurrently, see Config.MaxConcurrency. The servers are keyed by UUID,
+//if any of them cannot be fetched the error is a BulkError and the result holds the others.
+func (c *Client) GetServersByUUID(ids []string) (map[string]Server, error) {
+	ids = uniqueIDs(ids)
+	servers := make([]Server, len(ids))
+	errs := c.forEachConcurrently(len(ids), func(i int) (err error) {
+		servers[i], err = c.GetServer(ids[i])
+		return err
+	})
+	result := make(map[string]Server, len(ids))
+	for i, id := range ids {
+		if errs[i] == nil {
+			result[id] = servers[i]
+		}
+	}
+	return result, bulkError(ids, errs)
+}
+
+//GetStoragesByUUID gets storages concurrently, see Config.MaxConcurrency. The storages are keyed by UUID,
+//if any of them cannot be fetched the error is a BulkError and the result holds the others.
+func (c *Client) GetStoragesByUUID(ids []string) (map[string]Storage, error) {
+	ids = uniqueIDs(ids)
+	storages := make([]Storage, len(ids))
+	errs := c.forEachConcurrently(len(ids), func(i int) (err error) {
+		storages[i], err = c.GetStorage(ids[i])
+		return err
+	})
+	result := make(map[string]Storage, len(ids))
+	for i, id := range ids {
+		if errs[i] == nil {
+			result[id] = storages[i]
+		}
+	}
+	return result, bulkError(ids, errs)
+}
+
+//GetNetworksByUUID gets networks concurrently, see Config.MaxConcurrency. The networks are keyed by UUID,
+//if any of them cannot be fetched the error is a BulkError and the result holds the others.
+func (c *Client) GetNetworksByUUID(ids []string) (map[string]Network, error) {
+	ids = uniqueIDs(ids)
+	networks := make([]Network, len(ids))
+	errs := c.forEachConcurrently(len(ids), func(i int) (err error) {
+		networks[i], err = c.GetNetwork(ids[i])
+		return err
+	})
+	result := make(map[string]Network, len(ids))
+	for i, id := range ids {
+		if errs[i] == nil {
+			result[id] = networks[i]
+		}
+	}
+	return result, bulkError(ids, errs)
+}
+
+//GetIPsByUUID gets IPs concurrently, see Config.MaxConcurrency. The IPs are keyed by UUID,
+//if any of them cannot be fetched the error is a BulkError and the result holds the others.
+func (c *Client) GetIPsByUUID(ids []string) (map[string]IP, error) {
+	ids = uniqueIDs(ids)
+	ips := make([]IP, len(ids))
+	errs := c.forEachConcurrently(len(ids), func(i int) (err error) {
+		ips[i], err = c.GetIP(ids[i])
+		return err
+	})
+	result := make(map[string]IP, len(ids))
+	for i, id := range ids {
+		if errs[i] == nil {
+			result[id] = ips[i]
+		}
+	}
+	return result, bulkError(ids, errs)
+}
+
+//GetISOImagesByUUID gets ISO-Images concurrently, see Config.MaxConcurrency. The ISO-Images are keyed by UUID,
+//if any of them cannot be fetched the error is a BulkError and the result holds the others.
+func (c *Client) GetISOImagesByUUID(ids []string) (map[string]ISOImage, error) {
+	ids = uniqueIDs(ids)
+	isoImages := make([]ISOImage, len(ids))
+	errs := c.forEachConcurrently(len(ids), func(i int) (err error) {
+		isoImages[i], err = c.GetISOImage(ids[i])
+		return err
+	})
+	result := make(map[string]ISOImage, len(ids))
+	for i, id := range ids {
+		if errs[i] == nil {
+			result[id] = isoImages[i]
+		}
+	}
+	return result, bulkError(ids, errs)
+}
+
+//GetTemplatesByUUID gets templates concurrently, see Config.MaxConcurrency. The templates are keyed by UUID,
+//if any of them cannot be fetched the error is a BulkError and the result holds the others.
+func (c *Client) GetTemplatesByUUID(ids []string) (map[string]Template, error) {
+	ids = uniqueIDs(ids)
+	templates := make([]Template, len(ids))
+	errs := c.forEachConcurrently(len(ids), func(i int) (err error) {
+		templates[i], err = c.GetTemplate(ids[i])
+		return err
+	})
+	result := make(map[string]Template, len(ids))
+	for i, id := range ids {
+		if errs[i] == nil {
+			result[id] = templates[i]
+		}
+	}
+	return result, bulkError(ids, errs)
+}
+
+//GetSshkeysByUUID gets SSH-keys concurrently, see Config.MaxConcurrency. The SSH-keys are keyed by UUID,
+//if any of them cannot be fetched the error is a BulkError and the result holds the others.
+func (c *Client) GetSshkeysByUUID(ids []string) (map[string]Sshkey, error) {
+	ids = uniqueIDs(ids)
+	sshkeys := make([]Sshkey, len(ids))
+	errs := c.forEachConcurrently(len(ids), func(i int) (err error) {
+		sshkeys[i], err = c.GetSshkey(ids[i])
+		return err
+	})
+	result := make(map[string]Sshkey, len(ids))
+	for i, id := range ids {
+		if errs[i] == nil {
+			result[id] = sshkeys[i]
+		}
+	}
+	return result, bulkError(ids, errs)
+}
+
+//GetFirewallsByUUID gets firewalls concurrently, see Config.MaxConcurrency. The firewalls are keyed by UUID,
+//if any of them cannot be fetched the error is a BulkError and the result holds the others.
+func (c *Client) GetFirewallsByUUID(ids []string) (map[string]Firewall, error) {
+	ids = uniqueIDs(ids)
+	firewalls := make([]Firewall, len(ids))
+	errs := c.forEachConcurrently(len(ids), func(i int) (err error) {
+		firewalls[i], err = c.GetFirewall(ids[i])
+		return err
+	})
+	result := make(map[string]Firewall, len(ids))
+	for i, id := range ids {
+		if errs[i] == nil {
+			result[id] = firewalls[i]
+		}
+	}
+	return result, bulkError(ids, errs)
+}
+
+//GetLoadBalancersByUUID gets loadbalancers concurrently, see Config.MaxConcurrency. The loadbalancers are keyed by UUID,
+//if any of them cannot be fetched the error is a BulkError and the result holds the others.
+func (c *Client) GetLoadBalancersByUUID(ids []string) (map[string]LoadBalancer, error) {
+	ids = uniqueIDs(ids)
+	loadBalancers := make([]LoadBalancer, len(ids))
+	errs := c.forEachConcurrently(len(ids), func(i int) (err error) {
+		loadBalancers[i], err = c.GetLoadBalancer(ids[i])
+		return err
+	})
+	result := make(map[string]LoadBalancer, len(ids))
+	for i, id := range ids {
+		if errs[i] == nil {
+			result[id] = loadBalancers[i]
+		}
+	}
+	return result, bulkError(ids, errs)
+}
+
+//GetPaaSServicesByUUID gets PaaS services concurrently, see Config.MaxConcurrency. The PaaS services are keyed by UUID,
+//if any of them cannot be fetched the error is a BulkError and the result holds the others.
+func (c *Client) GetPaaSServicesByUUID(ids []string) (map[string]PaaSService, error) {
+	ids = uniqueIDs(ids)
+	paasServices := make([]PaaSService, len(ids))
+	errs := c.forEachConcurrently(len(ids), func(i int) (err error) {
+		paasServices[i], err = c.GetPaaSService(ids[i])
+		return err
+	})
+	result := make(map[string]PaaSService, len(ids))
+	for i, id := range ids {
+		if errs[i] == nil {
+			result[id] = paasServices[i]
+		}
+	}
+	return result, bulkError(ids, errs)
+}
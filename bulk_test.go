@@ -0,0 +1,92 @@
+package gsclient
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetStoragesByUUID(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	client.cfg.MaxConcurrency = 2
+	var mu sync.Mutex
+	running, maxRunning, requests := 0, 0, 0
+	mux.HandleFunc(apiStorageBase+"/", func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		mu.Lock()
+		requests++
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		if path.Base(request.URL.Path) == "missing" {
+			writer.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(writer, `{"status": "Not Found", "message": "storage not found"}`)
+			return
+		}
+		fmt.Fprintf(writer, `{"storage": {"object_uuid": "%s"}}`, path.Base(request.URL.Path))
+	})
+
+	ids := []string{"a", "b", "missing", "c", "a", "d", ""}
+	storages, err := client.GetStoragesByUUID(ids)
+	assert.Equal(t, 5, requests)
+	assert.True(t, maxRunning <= 2)
+	assert.Equal(t, 4, len(storages))
+	for _, id := range []string{"a", "b", "c", "d"} {
+		assert.Equal(t, id, storages[id].Properties.ObjectUUID)
+	}
+	if bulkError, ok := err.(BulkError); assert.True(t, ok) {
+		assert.Equal(t, 1, len(bulkError))
+		if requestError, ok := bulkError["missing"].(RequestError); assert.True(t, ok) {
+			assert.Equal(t, http.StatusNotFound, requestError.StatusCode)
+		}
+	}
+	assert.Equal(t, "1 of the objects have failed: missing: statuscode 404 returned: storage not found", err.Error())
+
+	storages, err = client.GetStoragesByUUID(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(storages))
+}
+
+func TestClient_GetByUUID(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	mux.HandleFunc("/", func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, "{}")
+	})
+	ids := []string{"a", "b"}
+	count := func(n int, err error) int {
+		assert.Nil(t, err)
+		return n
+	}
+	servers, err := client.GetServersByUUID(ids)
+	assert.Equal(t, 2, count(len(servers), err))
+	networks, err := client.GetNetworksByUUID(ids)
+	assert.Equal(t, 2, count(len(networks), err))
+	ips, err := client.GetIPsByUUID(ids)
+	assert.Equal(t, 2, count(len(ips), err))
+	isoImages, err := client.GetISOImagesByUUID(ids)
+	assert.Equal(t, 2, count(len(isoImages), err))
+	templates, err := client.GetTemplatesByUUID(ids)
+	assert.Equal(t, 2, count(len(templates), err))
+	sshkeys, err := client.GetSshkeysByUUID(ids)
+	assert.Equal(t, 2, count(len(sshkeys), err))
+	firewalls, err := client.GetFirewallsByUUID(ids)
+	assert.Equal(t, 2, count(len(firewalls), err))
+	loadBalancers, err := client.GetLoadBalancersByUUID(ids)
+	assert.Equal(t, 2, count(len(loadBalancers), err))
+	paasServices, err := client.GetPaaSServicesByUUID(ids)
+	assert.Equal(t, 2, count(len(paasServices), err))
+}
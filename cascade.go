@@ -0,0 +1,231 @@
+package gsclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+//KindStorageSnapshot kind of storage snapshots in cascade plans, the generic object functions don't support it
+const KindStorageSnapshot ObjectKind = "storage_snapshot"
+
+//CascadeAction action of a step of a cascading deletion
+type CascadeAction string
+
+//Actions of a cascading deletion
+const (
+	CascadeStop   CascadeAction = "stop"
+	CascadeUnlink CascadeAction = "unlink"
+	CascadeDelete CascadeAction = "delete"
+)
+
+//CascadeOptions options of a cascading deletion of a server. The server is always deleted, the related
+//objects only if they are included. Objects which are linked to other servers or load balancers are kept.
+type CascadeOptions struct {
+	//Storages deletes the storages of the server
+	Storages bool
+	//Snapshots deletes the snapshots of the deleted storages, storages with snapshots are kept otherwise
+	Snapshots bool
+	//IPs deletes the IP addresses of the server
+	IPs bool
+	//Exclude UUIDs of related objects which are kept
+	Exclude []string
+	//DryRun only plans the deletion
+	DryRun bool
+}
+
+//CascadeStep a step of a cascading deletion. ParentUUID is the storage of a snapshot,
+//Done is set once the step has been carried out.
+type CascadeStep struct {
+	Action     CascadeAction
+	Kind       ObjectKind
+	UUID       string
+	Name       string
+	ParentUUID string
+	Done       bool
+}
+
+//String returns the step as text
+func (s CascadeStep) String() string {
+	return fmt.Sprintf("%s %s %s (%s)", s.Action, s.Kind, s.UUID, s.Name)
+}
+
+//CascadeKept a related object which isn't deleted and the reason why
+type CascadeKept struct {
+	Kind   ObjectKind
+	UUID   string
+	Name   string
+	Reason string
+}
+
+//CascadePlan the steps of a cascading deletion in the order they are carried out
+//and the related objects which are kept
+type CascadePlan struct {
+	ServerUUID string
+	Steps      []CascadeStep
+	Kept       []CascadeKept
+}
+
+//String returns the plan as text, one step or kept object per line
+func (p CascadePlan) String() string {
+	var lines []string
+	for _, step := range p.Steps {
+		lines = append(lines, step.String())
+	}
+	for _, kept := range p.Kept {
+		lines = append(lines, fmt.Sprintf("keep %s %s (%s): %s", kept.Kind, kept.UUID, kept.Name, kept.Reason))
+	}
+	return strings.Join(lines, "\n")
+}
+
+//DeleteServerCascade deletes a server together with its related objects. The server is stopped, all its
+//relations are unlinked, then the server is deleted and, depending on the options, its storages with their
+//snapshots and its IP addresses. Storages and IP addresses which are linked to other servers or load
+//balancers are never deleted.
+//
+//The returned plan holds the steps, with DryRun nothing is changed. If a step fails, the deletion stops,
+//the steps carried out so far are marked as done.
+func (c *Client) DeleteServerCascade(id string, opts CascadeOptions) (CascadePlan, error) {
+	plan, err := c.planServerDeletion(id, opts)
+	if err != nil || opts.DryRun {
+		return plan, err
+	}
+	for i := range plan.Steps {
+		step := &plan.Steps[i]
+		if err := c.runCascadeStep(id, *step); err != nil {
+			return plan, fmt.Errorf("%v failed: %v", step, err)
+		}
+		step.Done = true
+	}
+	return plan, nil
+}
+
+//planServerDeletion collects the steps for deleting a server
+func (c *Client) planServerDeletion(id string, opts CascadeOptions) (CascadePlan, error) {
+	details, err := c.GetServerDetails(id)
+	if err != nil {
+		return CascadePlan{}, err
+	}
+	server := details.Server.Properties
+	plan := CascadePlan{ServerUUID: id}
+	excluded := make(map[string]bool, len(opts.Exclude))
+	for _, uuid := range opts.Exclude {
+		excluded[uuid] = true
+	}
+	keep := func(kind ObjectKind, uuid, name, reason string) {
+		plan.Kept = append(plan.Kept, CascadeKept{Kind: kind, UUID: uuid, Name: name, Reason: reason})
+	}
+	step := func(action CascadeAction, kind ObjectKind, uuid, name string) {
+		plan.Steps = append(plan.Steps, CascadeStep{Action: action, Kind: kind, UUID: uuid, Name: name})
+	}
+
+	if server.Power {
+		step(CascadeStop, KindServer, id, server.Name)
+	}
+	for _, iso := range details.ISOImages {
+		step(CascadeUnlink, KindISOImage, iso.Relation.ObjectUUID, iso.Relation.ObjectName)
+	}
+	for _, ip := range details.IPs {
+		step(CascadeUnlink, KindIP, ip.Relation.ObjectUUID, ip.IP.Properties.Name)
+	}
+	for _, network := range details.Networks {
+		step(CascadeUnlink, KindNetwork, network.Relation.ObjectUUID, network.Relation.ObjectName)
+	}
+	for _, storage := range details.Storages {
+		step(CascadeUnlink, KindStorage, storage.Relation.ObjectUUID, storage.Relation.ObjectName)
+	}
+	step(CascadeDelete, KindServer, id, server.Name)
+
+	for _, storage := range details.Storages {
+		properties := storage.Storage.Properties
+		var others []string
+		for _, relation := range properties.Relations.Servers {
+			if relation.ObjectUUID != id {
+				others = append(others, relation.ObjectUUID)
+			}
+		}
+		switch {
+		case !opts.Storages:
+			keep(KindStorage, properties.ObjectUUID, properties.Name, "storages not included")
+			continue
+		case excluded[properties.ObjectUUID]:
+			keep(KindStorage, properties.ObjectUUID, properties.Name, "excluded")
+			continue
+		case len(others) > 0:
+			keep(KindStorage, properties.ObjectUUID, properties.Name, "linked to servers "+strings.Join(others, ", "))
+			continue
+		}
+		snapshots, err := c.GetStorageSnapshotList(properties.ObjectUUID)
+		if err != nil {
+			return CascadePlan{}, err
+		}
+		if len(snapshots) > 0 && !opts.Snapshots {
+			keep(KindStorage, properties.ObjectUUID, properties.Name, "has snapshots")
+			continue
+		}
+		for _, snapshot := range snapshots {
+			plan.Steps = append(plan.Steps, CascadeStep{
+				Action:     CascadeDelete,
+				Kind:       KindStorageSnapshot,
+				UUID:       snapshot.Properties.ObjectUUID,
+				Name:       snapshot.Properties.Name,
+				ParentUUID: properties.ObjectUUID,
+			})
+		}
+		step(CascadeDelete, KindStorage, properties.ObjectUUID, properties.Name)
+	}
+
+	for _, ip := range details.IPs {
+		properties := ip.IP.Properties
+		var users []string
+		for _, relation := range properties.Relations.Servers {
+			if relation.ServerUUID != id {
+				users = append(users, "server "+relation.ServerUUID)
+			}
+		}
+		for _, relation := range properties.Relations.Loadbalancers {
+			users = append(users, "load balancer "+relation.LoadbalancerUUID)
+		}
+		switch {
+		case !opts.IPs:
+			keep(KindIP, properties.ObjectUUID, properties.Name, "IPs not included")
+		case excluded[properties.ObjectUUID]:
+			keep(KindIP, properties.ObjectUUID, properties.Name, "excluded")
+		case len(users) > 0:
+			keep(KindIP, properties.ObjectUUID, properties.Name, "linked to "+strings.Join(users, ", "))
+		default:
+			step(CascadeDelete, KindIP, properties.ObjectUUID, properties.Name)
+		}
+	}
+	return plan, nil
+}
+
+//runCascadeStep carries out a step of the deletion of a server
+func (c *Client) runCascadeStep(serverID string, step CascadeStep) error {
+	switch step.Action {
+	case CascadeStop:
+		return c.StopServer(step.UUID)
+	case CascadeUnlink:
+		switch step.Kind {
+		case KindISOImage:
+			return c.UnlinkIsoImage(serverID, step.UUID)
+		case KindIP:
+			return c.UnlinkIP(serverID, step.UUID)
+		case KindNetwork:
+			return c.UnlinkNetwork(serverID, step.UUID)
+		case KindStorage:
+			return c.UnlinkStorage(serverID, step.UUID)
+		}
+	case CascadeDelete:
+		switch step.Kind {
+		case KindServer:
+			return c.DeleteServer(step.UUID)
+		case KindStorageSnapshot:
+			return c.DeleteStorageSnapshot(step.ParentUUID, step.UUID)
+		case KindStorage:
+			return c.DeleteStorage(step.UUID)
+		case KindIP:
+			return c.DeleteIP(step.UUID)
+		}
+	}
+	return fmt.Errorf("unsupported step %v", step)
+}
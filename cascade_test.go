@@ -0,0 +1,102 @@
+package gsclient
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//prepareCascadeAPI serves a running server with a storage that has a snapshot, a storage shared with another
+//server, an IP address, an IP address used by a load balancer, a network and an ISO-Image
+func prepareCascadeAPI(mux *http.ServeMux) *fakeAPI {
+	api := newFakeAPI(mux)
+	api.setPower("server-1", true)
+	api.respond(http.MethodGet, "/objects/servers/server-1", `{"server": {"object_uuid": "server-1", "name": "web", "relations": {
+		"storages": [{"object_uuid": "storage-1", "object_name": "disk"}, {"object_uuid": "storage-2", "object_name": "shared"}],
+		"networks": [{"object_uuid": "network-1", "object_name": "lan"}],
+		"public_ips": [{"object_uuid": "ip-1"}, {"object_uuid": "ip-2"}],
+		"isoimages": [{"object_uuid": "iso-1", "object_name": "rescue"}]
+	}}}`)
+	api.respond(http.MethodGet, "/objects/storages/storage-1", `{"storage": {"object_uuid": "storage-1", "name": "disk",
+		"relations": {"servers": [{"object_uuid": "server-1"}]}}}`)
+	api.respond(http.MethodGet, "/objects/storages/storage-2", `{"storage": {"object_uuid": "storage-2", "name": "shared",
+		"relations": {"servers": [{"object_uuid": "server-1"}, {"object_uuid": "server-2"}]}}}`)
+	api.respond(http.MethodGet, "/objects/storages/storage-1/snapshots", `{"snapshots": {"snapshot-1": {"object_uuid": "snapshot-1", "name": "daily"}}}`)
+	api.respond(http.MethodGet, "/objects/networks/network-1", `{"network": {"object_uuid": "network-1", "name": "lan"}}`)
+	api.respond(http.MethodGet, "/objects/ips/ip-1", `{"ip": {"object_uuid": "ip-1", "name": "public",
+		"relations": {"servers": [{"server_uuid": "server-1"}]}}}`)
+	api.respond(http.MethodGet, "/objects/ips/ip-2", `{"ip": {"object_uuid": "ip-2", "name": "vip",
+		"relations": {"loadbalancers": [{"loadbalancer_uuid": "lb-1"}]}}}`)
+	api.respond(http.MethodGet, "/objects/isoimages/iso-1", `{"isoimage": {"object_uuid": "iso-1", "name": "rescue"}}`)
+	return api
+}
+
+func TestClient_DeleteServerCascade(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := prepareCascadeAPI(mux)
+
+	plan, err := client.DeleteServerCascade("server-1", CascadeOptions{Storages: true, Snapshots: true, IPs: true})
+	if err != nil {
+		t.Fatalf("DeleteServerCascade returned an error %v", err)
+	}
+	assert.Equal(t, []string{
+		"PATCH /objects/servers/server-1/power",
+		"DELETE /objects/servers/server-1/isoimages/iso-1",
+		"DELETE /objects/servers/server-1/ips/ip-1",
+		"DELETE /objects/servers/server-1/ips/ip-2",
+		"DELETE /objects/servers/server-1/networks/network-1",
+		"DELETE /objects/servers/server-1/storages/storage-1",
+		"DELETE /objects/servers/server-1/storages/storage-2",
+		"DELETE /objects/servers/server-1",
+		"DELETE /objects/storages/storage-1/snapshots/snapshot-1",
+		"DELETE /objects/storages/storage-1",
+		"DELETE /objects/ips/ip-1",
+	}, api.recorded())
+	for _, step := range plan.Steps {
+		assert.True(t, step.Done, step.String())
+	}
+	assert.Equal(t, []CascadeKept{
+		{Kind: KindStorage, UUID: "storage-2", Name: "shared", Reason: "linked to servers server-2"},
+		{Kind: KindIP, UUID: "ip-2", Name: "vip", Reason: "linked to load balancer lb-1"},
+	}, plan.Kept)
+}
+
+func TestClient_DeleteServerCascadeDryRun(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := prepareCascadeAPI(mux)
+
+	plan, err := client.DeleteServerCascade("server-1", CascadeOptions{Storages: true, Exclude: []string{"storage-2"}, DryRun: true})
+	if err != nil {
+		t.Fatalf("DeleteServerCascade returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(api.recorded()))
+	assert.Equal(t, `stop server server-1 (web)
+unlink isoimage iso-1 (rescue)
+unlink ip ip-1 (public)
+unlink ip ip-2 (vip)
+unlink network network-1 (lan)
+unlink storage storage-1 (disk)
+unlink storage storage-2 (shared)
+delete server server-1 (web)
+keep storage storage-1 (disk): has snapshots
+keep storage storage-2 (shared): excluded
+keep ip ip-1 (public): IPs not included
+keep ip ip-2 (vip): IPs not included`, plan.String())
+}
+
+func TestClient_DeleteServerCascadeFailure(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := prepareCascadeAPI(mux)
+	api.setPower("server-1", false)
+	api.fail(http.MethodDelete, "/objects/servers/server-1", http.StatusConflict)
+
+	plan, err := client.DeleteServerCascade("server-1", CascadeOptions{})
+	assert.Error(t, err)
+	assert.Equal(t, CascadeStep{Action: CascadeUnlink, Kind: KindStorage, UUID: "storage-2", Name: "shared", Done: true}, plan.Steps[5])
+	assert.Equal(t, CascadeStep{Action: CascadeDelete, Kind: KindServer, UUID: "server-1", Name: "web"}, plan.Steps[6])
+	assert.Equal(t, 7, len(plan.Steps))
+}
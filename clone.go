@@ -0,0 +1,215 @@
+package gsclient
+
+//CloneOptions options of cloning a server
+type CloneOptions struct {
+	//Name name of the new server, the name of the server with the suffix "-clone" if empty
+	Name string
+	//IPFamilies a new IP address is created for each family (4 or 6) and linked to the new server
+	IPFamilies []int
+	//StopSource shuts a running server down while its storages are snapshotted, so the snapshots are
+	//consistent. The server is started again once the snapshots have been taken, also if taking them fails.
+	StopSource bool
+	//Shutdown policy of shutting down the server for StopSource
+	Shutdown ShutdownPolicy
+	//Start starts the new server once it's set up
+	Start bool
+}
+
+//ClonedServer the objects created by CloneServer. StorageUUIDs are in the order of the storages of the server.
+type ClonedServer struct {
+	ServerUUID   string
+	StorageUUIDs []string
+	IPUUIDs      []string
+}
+
+//CloneServer creates a copy of a server with the same cores, memory, hardware profile, labels and ISO-Images.
+//Every storage of the server is snapshotted, a template is made of each snapshot and the copy of the storage is
+//created from the template. The copies are linked to the new server in the same way. The networks are linked
+//to the new server with the same firewall template, ordering and L3security. The IP addresses of the server
+//aren't copied, new ones can be created with IPFamilies.
+//
+//The snapshots of a running server are taken while it keeps running, like after a power loss, unless StopSource
+//is set. The snapshots and templates are deleted once the server has been cloned. If a step fails, all objects
+//created so far are deleted in reverse order and the error is a ProvisionError.
+func (c *Client) CloneServer(id string, opts CloneOptions) (ClonedServer, error) {
+	details, err := c.GetServerDetails(id)
+	if err != nil {
+		return ClonedServer{}, err
+	}
+	source := details.Server.Properties
+	name := opts.Name
+	if name == "" {
+		name = source.Name + "-clone"
+	}
+
+	var result ClonedServer
+	var undo []undoStep
+	//The snapshots and templates are only needed until the storages have been created from them
+	var temporary []undoStep
+	fail := func(step string, err error) (ClonedServer, error) {
+		c.cfg.logger.Errorf("Cloning of server %v failed at step %v: %v", id, step, err)
+		return ClonedServer{}, ProvisionError{Step: step, Err: err, RollbackErr: rollback(append(temporary, undo...))}
+	}
+
+	stopSource := opts.StopSource && source.Power
+	if stopSource {
+		if err := c.ShutdownServerWithPolicy(id, opts.Shutdown); err != nil {
+			return fail("shut down server", err)
+		}
+		//As the first undo step it's run last, the server is started again whatever fails
+		undo = append(undo, undoStep{"start server " + id, func() error {
+			return c.StartServer(id)
+		}})
+	}
+	snapshots := make([]string, len(details.Storages))
+	for i, storage := range details.Storages {
+		storageID := storage.Relation.ObjectUUID
+		snapshot, err := c.CreateStorageSnapshot(storageID, StorageSnapshotCreateRequest{Name: name})
+		snapshotID := snapshot.ObjectUUID
+		if snapshotID != "" {
+			temporary = append(temporary, undoStep{"delete snapshot " + snapshotID, func() error {
+				return c.DeleteStorageSnapshot(storageID, snapshotID)
+			}})
+		}
+		if err != nil {
+			return fail("snapshot storage", err)
+		}
+		snapshots[i] = snapshotID
+	}
+	if stopSource {
+		if err := c.StartServer(id); err != nil {
+			return fail("start server", err)
+		}
+		undo = undo[1:]
+	}
+
+	storages := make([]string, len(details.Storages))
+	for i, storage := range details.Storages {
+		template, err := c.CreateTemplate(TemplateCreateRequest{Name: name, SnapshotUUID: snapshots[i]})
+		templateID := template.ObjectUUID
+		if templateID != "" {
+			temporary = append(temporary, undoStep{"delete template " + templateID, func() error {
+				return c.DeleteTemplate(templateID)
+			}})
+		}
+		if err == nil {
+			err = c.WaitForRequestCompletion(template.RequestUUID)
+		}
+		if err != nil {
+			return fail("create template", err)
+		}
+
+		properties := storage.Storage.Properties
+		copied, err := c.CreateStorage(StorageCreateRequest{
+			Capacity:     properties.Capacity,
+			LocationUUID: properties.LocationUUID,
+			Name:         name,
+			StorageType:  properties.StorageType,
+			Template:     &StorageTemplate{TemplateUUID: templateID},
+			Labels:       properties.Labels,
+		})
+		copyID := copied.ObjectUUID
+		if copyID != "" {
+			undo = append(undo, undoStep{"delete storage " + copyID, func() error {
+				return c.DeleteStorage(copyID)
+			}})
+		}
+		if err != nil {
+			return fail("create storage", err)
+		}
+		storages[i] = copyID
+	}
+
+	server, err := c.CreateServer(ServerCreateRequest{
+		Name:            name,
+		Cores:           source.Cores,
+		Memory:          source.Memory,
+		LocationUUID:    source.LocationUUID,
+		HardwareProfile: source.HardwareProfile,
+		AvailablityZone: source.AvailablityZone,
+		Labels:          source.Labels,
+		AutoRecovery:    source.AutoRecovery,
+	})
+	serverID := server.ObjectUUID
+	if serverID != "" {
+		undo = append(undo, undoStep{"delete server " + serverID, func() error {
+			return c.DeleteServer(serverID)
+		}})
+	}
+	if err != nil {
+		return fail("create server", err)
+	}
+
+	for i, storage := range details.Storages {
+		storageID := storages[i]
+		if err := c.LinkStorage(serverID, storageID, storage.Relation.BootDevice); err != nil {
+			return fail("link storage", err)
+		}
+		undo = append(undo, undoStep{"unlink storage " + storageID, func() error {
+			return c.UnlinkStorage(serverID, storageID)
+		}})
+	}
+
+	for _, network := range details.Networks {
+		relation := network.Relation
+		err := c.LinkNetwork(serverID, relation.ObjectUUID, relation.FirewallTemplateUUID, relation.BootDevice,
+			relation.Ordering, relation.L3security, FirewallRules{})
+		if err != nil {
+			return fail("link network", err)
+		}
+		undo = append(undo, undoStep{"unlink network " + relation.ObjectUUID, func() error {
+			return c.UnlinkNetwork(serverID, relation.ObjectUUID)
+		}})
+	}
+
+	for _, family := range opts.IPFamilies {
+		ip, err := c.CreateIP(IPCreateRequest{
+			Name:         name,
+			Family:       family,
+			LocationUUID: source.LocationUUID,
+			Labels:       source.Labels,
+		})
+		ipID := ip.ObjectUUID
+		if ipID != "" {
+			undo = append(undo, undoStep{"delete IP " + ipID, func() error {
+				return c.DeleteIP(ipID)
+			}})
+		}
+		if err != nil {
+			return fail("create IP", err)
+		}
+		result.IPUUIDs = append(result.IPUUIDs, ipID)
+		if err := c.LinkIP(serverID, ipID); err != nil {
+			return fail("link IP", err)
+		}
+		undo = append(undo, undoStep{"unlink IP " + ipID, func() error {
+			return c.UnlinkIP(serverID, ipID)
+		}})
+	}
+
+	for _, iso := range details.ISOImages {
+		isoImageID := iso.Relation.ObjectUUID
+		if err := c.LinkIsoImage(serverID, isoImageID); err != nil {
+			return fail("link ISO-Image", err)
+		}
+		undo = append(undo, undoStep{"unlink ISO-Image " + isoImageID, func() error {
+			return c.UnlinkIsoImage(serverID, isoImageID)
+		}})
+	}
+
+	if opts.Start {
+		if err := c.StartServer(serverID); err != nil {
+			undo = append(undo, undoStep{"stop server " + serverID, func() error {
+				return c.StopServer(serverID)
+			}})
+			return fail("start server", err)
+		}
+	}
+
+	result.ServerUUID = serverID
+	result.StorageUUIDs = storages
+	if err := rollback(temporary); err != nil {
+		c.cfg.logger.Errorf("Deleting the snapshots and templates taken for cloning server %v failed: %v", id, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,129 @@
+package gsclient
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//prepareCloneAPI serves a server with two storages, their snapshots, a network with a firewall template and an ISO-Image
+func prepareCloneAPI(mux *http.ServeMux) *fakeAPI {
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, "/objects/servers/server-1", `{"server": {"object_uuid": "server-1", "name": "web",
+		"cores": 2, "memory": 4, "hardware_profile": "legacy", "labels": ["env=dev"], "relations": {
+		"storages": [{"object_uuid": "storage-1", "bootdevice": true}, {"object_uuid": "storage-2"}],
+		"networks": [{"object_uuid": "network-1", "firewall_template_uuid": "firewall-1", "ordering": 1, "l3security": ["10.0.0.1"]}],
+		"isoimages": [{"object_uuid": "iso-1"}]
+	}}}`)
+	api.respond(http.MethodGet, "/objects/storages/storage-1", `{"storage": {"object_uuid": "storage-1", "capacity": 10,
+		"location_uuid": "location-1", "storage_type": "storage_high", "labels": ["env=dev"]}}`)
+	api.respond(http.MethodGet, "/objects/storages/storage-2", `{"storage": {"object_uuid": "storage-2", "capacity": 20,
+		"location_uuid": "location-1", "storage_type": "storage"}}`)
+	api.respond(http.MethodGet, "/objects/networks/network-1", `{"network": {"object_uuid": "network-1"}}`)
+	api.respond(http.MethodGet, "/objects/firewalls/firewall-1", `{"firewall": {"object_uuid": "firewall-1"}}`)
+	api.respond(http.MethodGet, "/objects/isoimages/iso-1", `{"isoimage": {"object_uuid": "iso-1"}}`)
+	api.respond(http.MethodPost, "/objects/storages/storage-1/snapshots", `{"object_uuid": "snapshot-1", "request_uuid": "r1"}`)
+	api.respond(http.MethodPost, "/objects/storages/storage-2/snapshots", `{"object_uuid": "snapshot-2", "request_uuid": "r2"}`)
+	api.respond(http.MethodPost, apiTemplateBase, `{"object_uuid": "template-1", "request_uuid": "r3"}`)
+	api.respond(http.MethodPost, apiStorageBase, `{"object_uuid": "copy-1", "request_uuid": "r4"}`)
+	api.respond(http.MethodPost, apiServerBase, `{"object_uuid": "server-2", "request_uuid": "r5"}`)
+	api.respond(http.MethodPost, apiIPBase, `{"object_uuid": "ip-1", "request_uuid": "r6"}`)
+	return api
+}
+
+func TestClient_CloneServer(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := prepareCloneAPI(mux)
+
+	result, err := client.CloneServer("server-1", CloneOptions{IPFamilies: []int{4}})
+	if err != nil {
+		t.Fatalf("CloneServer returned an error %v", err)
+	}
+	assert.Equal(t, ClonedServer{ServerUUID: "server-2", StorageUUIDs: []string{"copy-1", "copy-1"}, IPUUIDs: []string{"ip-1"}}, result)
+	assert.Equal(t, []string{
+		"POST /objects/storages/storage-1/snapshots",
+		"POST /objects/storages/storage-2/snapshots",
+		"POST /objects/templates",
+		"POST /objects/storages",
+		"POST /objects/templates",
+		"POST /objects/storages",
+		"POST /objects/servers",
+		"POST /objects/servers/server-2/storages",
+		"POST /objects/servers/server-2/storages",
+		"POST /objects/servers/server-2/networks",
+		"POST /objects/ips",
+		"POST /objects/servers/server-2/ips",
+		"POST /objects/servers/server-2/isoimages",
+		"DELETE /objects/templates/template-1",
+		"DELETE /objects/templates/template-1",
+		"DELETE /objects/storages/storage-2/snapshots/snapshot-2",
+		"DELETE /objects/storages/storage-1/snapshots/snapshot-1",
+	}, api.recorded())
+	bodies := api.recordedBodies()
+	assert.JSONEq(t, `{"name": "web-clone", "snapshot_uuid": "snapshot-1"}`, bodies[2])
+	assert.JSONEq(t, `{"name": "web-clone", "capacity": 10, "location_uuid": "location-1", "storage_type": "storage_high",
+		"template": {"template_uuid": "template-1"}, "labels": ["env=dev"]}`, bodies[3])
+	assert.JSONEq(t, `{"name": "web-clone", "snapshot_uuid": "snapshot-2"}`, bodies[4])
+}
+
+func TestClient_CloneServerStopSource(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := prepareCloneAPI(mux)
+	api.setPower("server-1", true)
+
+	//The server is only stopped while its storages are snapshotted
+	_, err := client.CloneServer("server-1", CloneOptions{StopSource: true})
+	if err != nil {
+		t.Fatalf("CloneServer returned an error %v", err)
+	}
+	assert.Equal(t, []string{
+		"PATCH /objects/servers/server-1/shutdown",
+		"POST /objects/storages/storage-1/snapshots",
+		"POST /objects/storages/storage-2/snapshots",
+		"PATCH /objects/servers/server-1/power",
+		"POST /objects/templates",
+	}, api.recorded()[:5])
+
+	//The server is started again if snapshotting its storages fails
+	api.fail(http.MethodPost, "/objects/storages/storage-2/snapshots", http.StatusBadRequest)
+	before := len(api.recorded())
+	_, err = client.CloneServer("server-1", CloneOptions{StopSource: true})
+	if provisionError, ok := err.(ProvisionError); assert.True(t, ok) {
+		assert.Equal(t, "snapshot storage", provisionError.Step)
+		assert.Nil(t, provisionError.RollbackErr)
+	}
+	assert.Equal(t, []string{
+		"PATCH /objects/servers/server-1/shutdown",
+		"POST /objects/storages/storage-1/snapshots",
+		"POST /objects/storages/storage-2/snapshots",
+		"PATCH /objects/servers/server-1/power",
+		"DELETE /objects/storages/storage-1/snapshots/snapshot-1",
+	}, api.recorded()[before:])
+}
+
+func TestClient_CloneServerRollback(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := prepareCloneAPI(mux)
+	api.fail(http.MethodPost, "/objects/servers/server-2/networks", http.StatusBadRequest)
+
+	_, err := client.CloneServer("server-1", CloneOptions{Name: "debug"})
+	if provisionError, ok := err.(ProvisionError); assert.True(t, ok) {
+		assert.Equal(t, "link network", provisionError.Step)
+		assert.Nil(t, provisionError.RollbackErr)
+	}
+	assert.Equal(t, []string{
+		"DELETE /objects/servers/server-2/storages/copy-1",
+		"DELETE /objects/servers/server-2/storages/copy-1",
+		"DELETE /objects/servers/server-2",
+		"DELETE /objects/storages/copy-1",
+		"DELETE /objects/storages/copy-1",
+		"DELETE /objects/templates/template-1",
+		"DELETE /objects/templates/template-1",
+		"DELETE /objects/storages/storage-2/snapshots/snapshot-2",
+		"DELETE /objects/storages/storage-1/snapshots/snapshot-1",
+	}, api.recorded()[10:])
+}
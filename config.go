@@ -1,19 +1,60 @@
 package gsclient
 
 import (
+	"bufio"
+	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	//DefaultAPIURL is the URL of gridscale's public API
+	DefaultAPIURL = "https://api.gridscale.io"
+
+	//DefaultProfile is the profile used when none is given
+	DefaultProfile = "default"
+
+	envAPIURL     = "GRIDSCALE_URL"
+	envUserUUID   = "GRIDSCALE_UUID"
+	envAPIToken   = "GRIDSCALE_TOKEN"
+	envProfile    = "GRIDSCALE_PROFILE"
+	envConfigFile = "GRIDSCALE_CONFIG_FILE"
+
+	profileKeyAPIURL   = "api_url"
+	profileKeyUserUUID = "user_uuid"
+	profileKeyAPIToken = "api_token"
+)
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
 //Config config for client
 type Config struct {
 	APIUrl     string
 	UserUUID   string
 	APIToken   string
 	HTTPClient *http.Client
-	logger     logrus.Logger
+	//Credentials is consulted for every request if set, otherwise UserUUID and APIToken are used
+	Credentials CredentialsProvider
+	//UserAgent is sent with every request, see UserAgent for adding the name of an application
+	UserAgent string
+	//DisableCompression stops asking the API for gzip compressed responses
+	DisableCompression bool
+	//DryRun sends GET requests as usual but only logs and records all other requests, see Client.RecordedRequests
+	DryRun bool
+	//AuditSink receives an entry for every create, update or delete request if set
+	AuditSink AuditSink
+	//RequestsPerSecond limits the requests sent by all clients using the config, 0 means no limit
+	RequestsPerSecond float64
+	//MaxConcurrency is the number of requests bulk operations send concurrently, DefaultMaxConcurrency if 0
+	MaxConcurrency int
+	logger         logrus.Logger
+	dryRun         dryRunRecorder
+	rateLimiter    rateLimiter
 }
 
 //NewConfiguration creates a new config
@@ -37,7 +78,137 @@ func NewConfiguration(apiURL string, uuid string, token string, debugMode bool)
 		UserUUID:   uuid,
 		APIToken:   token,
 		HTTPClient: http.DefaultClient,
+		UserAgent:  DefaultUserAgent(),
 		logger:     logger,
 	}
 	return cfg
 }
+
+//credentials returns the credentials to use for the next request
+func (cfg *Config) credentials() (Credentials, error) {
+	if cfg.Credentials == nil {
+		return Credentials{UserUUID: cfg.UserUUID, APIToken: cfg.APIToken}, nil
+	}
+	return cfg.Credentials.Credentials()
+}
+
+//LoadConfiguration creates a new config from environment variables and a profile file.
+//
+//The user UUID and API token are always taken together from one source, so credentials of different
+//accounts are never mixed. If a profile is given, its credentials and API URL are used. Otherwise the
+//environment variables GRIDSCALE_UUID and GRIDSCALE_TOKEN are used if one of them is set, else the
+//profile GRIDSCALE_PROFILE or DefaultProfile. GRIDSCALE_URL is used if the source of the credentials
+//has no API URL, DefaultAPIURL if it isn't set either.
+//
+//The profile file is read from GRIDSCALE_CONFIG_FILE or else from DefaultProfileFilePath. An error is
+//returned if a profile was asked for explicitly but cannot be found, or if the source of the credentials
+//lacks the user UUID or the API token.
+func LoadConfiguration(profile string, debugMode bool) (*Config, error) {
+	profileArgument := profile != ""
+	explicitProfile := profileArgument
+	if !explicitProfile {
+		profile = os.Getenv(envProfile)
+		explicitProfile = profile != ""
+	}
+	if !explicitProfile {
+		profile = DefaultProfile
+	}
+
+	fileName := os.Getenv(envConfigFile)
+	explicitFile := fileName != ""
+	if !explicitFile {
+		fileName = DefaultProfileFilePath()
+	}
+
+	values := map[string]string{}
+	profiles, err := readProfileFile(fileName)
+	switch {
+	case err == nil:
+		if p, ok := profiles[profile]; ok {
+			values = p
+		} else if explicitProfile {
+			return nil, fmt.Errorf("profile %q not found in %s", profile, fileName)
+		}
+	case os.IsNotExist(err) && !explicitFile && !explicitProfile:
+		//The profile file is optional as long as nothing points to it
+	default:
+		return nil, err
+	}
+
+	apiURL := firstNonEmpty(values[profileKeyAPIURL], os.Getenv(envAPIURL), DefaultAPIURL)
+	uuid, token := values[profileKeyUserUUID], values[profileKeyAPIToken]
+	envUUID, envToken := os.Getenv(envUserUUID), os.Getenv(envAPIToken)
+	if !profileArgument && (envUUID != "" || envToken != "") {
+		if envUUID == "" || envToken == "" {
+			return nil, fmt.Errorf("incomplete gridscale credentials: %s and %s must be set together", envUserUUID, envAPIToken)
+		}
+		apiURL = firstNonEmpty(os.Getenv(envAPIURL), DefaultAPIURL)
+		uuid, token = envUUID, envToken
+	} else if uuid == "" || token == "" {
+		return nil, fmt.Errorf("missing gridscale credentials: set %s and %s or add %s and %s to profile %q in %s",
+			envUserUUID, envAPIToken, profileKeyUserUUID, profileKeyAPIToken, profile, fileName)
+	}
+	if !isValidUUID(uuid) {
+		return nil, fmt.Errorf("user UUID %q is not a valid UUID", uuid)
+	}
+	return NewConfiguration(apiURL, uuid, token, debugMode), nil
+}
+
+//DefaultProfileFilePath returns the default location of the profile file, ~/.gridscale/config
+func DefaultProfileFilePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".gridscale", "config")
+	}
+	return filepath.Join(home, ".gridscale", "config")
+}
+
+//readProfileFile reads an INI-style profile file. Each profile starts with a [name] header
+//followed by key = value lines. Empty lines and lines starting with # or ; are ignored.
+func readProfileFile(fileName string) (map[string]map[string]string, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	profiles := map[string]map[string]string{}
+	var current map[string]string
+	scanner := bufio.NewScanner(file)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = map[string]string{}
+			}
+			current = profiles[name]
+			continue
+		}
+		idx := strings.Index(line, "=")
+		if idx < 0 || current == nil {
+			return nil, fmt.Errorf("%s:%d: invalid line in profile file", fileName, lineNo)
+		}
+		current[strings.TrimSpace(line[:idx])] = strings.TrimSpace(line[idx+1:])
+	}
+	return profiles, scanner.Err()
+}
+
+//isValidUUID checks whether a string is a UUID in its canonical textual form
+func isValidUUID(s string) bool {
+	return uuidRegexp.MatchString(s)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,199 @@
+package gsclient
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const dummyProfileFile = `# gridscale profiles
+[default]
+user_uuid = 690de890-13c0-4e76-8a01-e10ba8786e53
+api_token = default-token
+
+[project-x]
+api_url   = https://api.example.com
+user_uuid = 11111111-2222-3333-4444-555555555555
+api_token = project-token
+`
+
+//setEnv sets environment variables for a test and returns a function restoring the previous values
+func setEnv(values map[string]string) func() {
+	old := map[string]*string{}
+	for key, value := range values {
+		if prev, ok := os.LookupEnv(key); ok {
+			old[key] = &prev
+		} else {
+			old[key] = nil
+		}
+		if value == "" {
+			os.Unsetenv(key)
+		} else {
+			os.Setenv(key, value)
+		}
+	}
+	return func() {
+		for key, prev := range old {
+			if prev == nil {
+				os.Unsetenv(key)
+			} else {
+				os.Setenv(key, *prev)
+			}
+		}
+	}
+}
+
+func writeTestProfileFile(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "gsclient")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileName := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(fileName, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return fileName, func() { os.RemoveAll(dir) }
+}
+
+func TestLoadConfiguration_Profile(t *testing.T) {
+	fileName, cleanup := writeTestProfileFile(t, dummyProfileFile)
+	defer cleanup()
+	defer setEnv(map[string]string{
+		envConfigFile: fileName,
+		envAPIURL:     "",
+		envUserUUID:   "",
+		envAPIToken:   "",
+		envProfile:    "",
+	})()
+
+	cfg, err := LoadConfiguration("", false)
+	if err != nil {
+		t.Fatalf("LoadConfiguration returned an error %v", err)
+	}
+	assert.Equal(t, DefaultAPIURL, cfg.APIUrl)
+	assert.Equal(t, dummyUUID, cfg.UserUUID)
+	assert.Equal(t, "default-token", cfg.APIToken)
+
+	cfg, err = LoadConfiguration("project-x", false)
+	if err != nil {
+		t.Fatalf("LoadConfiguration returned an error %v", err)
+	}
+	assert.Equal(t, "https://api.example.com", cfg.APIUrl)
+	assert.Equal(t, "11111111-2222-3333-4444-555555555555", cfg.UserUUID)
+	assert.Equal(t, "project-token", cfg.APIToken)
+
+	_, err = LoadConfiguration("unknown", false)
+	assert.Error(t, err)
+}
+
+func TestLoadConfiguration_EnvOverridesProfile(t *testing.T) {
+	fileName, cleanup := writeTestProfileFile(t, dummyProfileFile)
+	defer cleanup()
+	defer setEnv(map[string]string{
+		envConfigFile: fileName,
+		envAPIURL:     "",
+		envUserUUID:   dummyUUID,
+		envAPIToken:   "env-token",
+		envProfile:    "project-x",
+	})()
+
+	//The credentials of the environment replace the whole profile, also its API URL
+	cfg, err := LoadConfiguration("", false)
+	if err != nil {
+		t.Fatalf("LoadConfiguration returned an error %v", err)
+	}
+	assert.Equal(t, DefaultAPIURL, cfg.APIUrl)
+	assert.Equal(t, dummyUUID, cfg.UserUUID)
+	assert.Equal(t, "env-token", cfg.APIToken)
+
+	//A profile given as argument wins over the environment
+	cfg, err = LoadConfiguration("project-x", false)
+	if err != nil {
+		t.Fatalf("LoadConfiguration returned an error %v", err)
+	}
+	assert.Equal(t, "https://api.example.com", cfg.APIUrl)
+	assert.Equal(t, "11111111-2222-3333-4444-555555555555", cfg.UserUUID)
+	assert.Equal(t, "project-token", cfg.APIToken)
+}
+
+func TestLoadConfiguration_CredentialsNotMixed(t *testing.T) {
+	fileName, cleanup := writeTestProfileFile(t, dummyProfileFile)
+	defer cleanup()
+	defer setEnv(map[string]string{
+		envConfigFile: fileName,
+		envAPIURL:     "",
+		envUserUUID:   "",
+		envAPIToken:   "env-token",
+		envProfile:    "project-x",
+	})()
+
+	//The token of the environment isn't paired with the user UUID of the profile
+	_, err := LoadConfiguration("", false)
+	assert.Error(t, err)
+
+	os.Setenv(envAPIToken, "")
+	os.Setenv(envUserUUID, dummyUUID)
+	_, err = LoadConfiguration("", false)
+	assert.Error(t, err)
+
+	cfg, err := LoadConfiguration("default", false)
+	if err != nil {
+		t.Fatalf("LoadConfiguration returned an error %v", err)
+	}
+	assert.Equal(t, dummyUUID, cfg.UserUUID)
+	assert.Equal(t, "default-token", cfg.APIToken)
+}
+
+func TestLoadConfiguration_EnvOnly(t *testing.T) {
+	defer setEnv(map[string]string{
+		envConfigFile: filepath.Join(os.TempDir(), "gsclient-does-not-exist"),
+		envAPIURL:     "",
+		envUserUUID:   dummyUUID,
+		envAPIToken:   "token",
+		envProfile:    "",
+		"HOME":        os.TempDir(),
+	})()
+
+	//The profile file has been pointed to explicitly, so it must exist
+	_, err := LoadConfiguration("", false)
+	assert.Error(t, err)
+
+	os.Unsetenv(envConfigFile)
+	cfg, err := LoadConfiguration("", false)
+	if err != nil {
+		t.Fatalf("LoadConfiguration returned an error %v", err)
+	}
+	assert.Equal(t, DefaultAPIURL, cfg.APIUrl)
+	assert.Equal(t, dummyUUID, cfg.UserUUID)
+	assert.Equal(t, "token", cfg.APIToken)
+}
+
+func TestLoadConfiguration_Validation(t *testing.T) {
+	fileName, cleanup := writeTestProfileFile(t, "[default]\nuser_uuid = not-a-uuid\napi_token = token\n")
+	defer cleanup()
+	defer setEnv(map[string]string{
+		envConfigFile: fileName,
+		envAPIURL:     "",
+		envUserUUID:   "",
+		envAPIToken:   "",
+		envProfile:    "",
+	})()
+
+	_, err := LoadConfiguration("", false)
+	assert.Error(t, err)
+
+	os.Setenv(envUserUUID, dummyUUID)
+	os.Setenv(envConfigFile, filepath.Join(os.TempDir(), "gsclient-does-not-exist"))
+	_, err = LoadConfiguration(DefaultProfile, false)
+	assert.Error(t, err)
+}
+
+func TestReadProfileFile_InvalidLine(t *testing.T) {
+	fileName, cleanup := writeTestProfileFile(t, "user_uuid = outside of a profile\n")
+	defer cleanup()
+	_, err := readProfileFile(fileName)
+	assert.Error(t, err)
+}
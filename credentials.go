@@ -0,0 +1,124 @@
+package gsclient
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+//Credentials a user UUID and an API token used to authenticate requests
+type Credentials struct {
+	UserUUID string
+	APIToken string
+}
+
+//CredentialsProvider provides the credentials for requests.
+//Credentials is called before every request. Refresh is called when the API rejects the credentials,
+//the request is then retried once.
+type CredentialsProvider interface {
+	Credentials() (Credentials, error)
+	Refresh() error
+}
+
+//StaticCredentialsProvider always provides the same credentials
+type StaticCredentialsProvider struct {
+	credentials Credentials
+}
+
+//NewStaticCredentialsProvider creates a new provider for fixed credentials
+func NewStaticCredentialsProvider(uuid, token string) *StaticCredentialsProvider {
+	return &StaticCredentialsProvider{
+		credentials: Credentials{UserUUID: uuid, APIToken: token},
+	}
+}
+
+//Credentials returns the fixed credentials
+func (p *StaticCredentialsProvider) Credentials() (Credentials, error) {
+	return p.credentials, nil
+}
+
+//Refresh does nothing, static credentials can't change
+func (p *StaticCredentialsProvider) Refresh() error {
+	return nil
+}
+
+//FileCredentialsProvider reads the credentials from a profile of a profile file
+//(see LoadConfiguration for the format) and reloads them whenever the file changes
+type FileCredentialsProvider struct {
+	fileName    string
+	profile     string
+	mu          sync.Mutex
+	modTime     time.Time
+	size        int64
+	credentials Credentials
+}
+
+//NewFileCredentialsProvider creates a new provider reading the given profile of a profile file.
+//The file is read once right away, so an error is returned if it doesn't hold valid credentials.
+func NewFileCredentialsProvider(fileName, profile string) (*FileCredentialsProvider, error) {
+	if profile == "" {
+		profile = DefaultProfile
+	}
+	p := &FileCredentialsProvider{
+		fileName: fileName,
+		profile:  profile,
+	}
+	if err := p.Refresh(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+//Credentials returns the current credentials, reloading the file first if it has been changed
+func (p *FileCredentialsProvider) Credentials() (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	info, err := os.Stat(p.fileName)
+	if err != nil {
+		return Credentials{}, err
+	}
+	if !info.ModTime().Equal(p.modTime) || info.Size() != p.size {
+		if err := p.load(); err != nil {
+			return Credentials{}, err
+		}
+	}
+	return p.credentials, nil
+}
+
+//Refresh reloads the credentials from the file
+func (p *FileCredentialsProvider) Refresh() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.load()
+}
+
+//load reads the file, must be called with the lock held
+func (p *FileCredentialsProvider) load() error {
+	info, err := os.Stat(p.fileName)
+	if err != nil {
+		return err
+	}
+	profiles, err := readProfileFile(p.fileName)
+	if err != nil {
+		return err
+	}
+	values, ok := profiles[p.profile]
+	if !ok {
+		return fmt.Errorf("profile %q not found in %s", p.profile, p.fileName)
+	}
+	credentials := Credentials{
+		UserUUID: values[profileKeyUserUUID],
+		APIToken: values[profileKeyAPIToken],
+	}
+	if credentials.UserUUID == "" || credentials.APIToken == "" {
+		return fmt.Errorf("profile %q in %s is missing %s or %s", p.profile, p.fileName, profileKeyUserUUID, profileKeyAPIToken)
+	}
+	if !isValidUUID(credentials.UserUUID) {
+		return fmt.Errorf("user UUID %q is not a valid UUID", credentials.UserUUID)
+	}
+	p.credentials = credentials
+	p.modTime = info.ModTime()
+	p.size = info.Size()
+	return nil
+}
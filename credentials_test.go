@@ -0,0 +1,108 @@
+package gsclient
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//rotatingCredentialsProvider hands out a new token on every refresh
+type rotatingCredentialsProvider struct {
+	tokens    []string
+	current   int
+	refreshes int
+}
+
+func (p *rotatingCredentialsProvider) Credentials() (Credentials, error) {
+	return Credentials{UserUUID: dummyUUID, APIToken: p.tokens[p.current]}, nil
+}
+
+func (p *rotatingCredentialsProvider) Refresh() error {
+	p.refreshes++
+	if p.current < len(p.tokens)-1 {
+		p.current++
+	}
+	return nil
+}
+
+func TestClient_CredentialsProvider(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	provider := &rotatingCredentialsProvider{tokens: []string{"old-token", "new-token"}}
+	client.cfg.Credentials = provider
+	uri := path.Join(apiServerBase, dummyUUID)
+	var tokens []string
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, dummyUUID, request.Header.Get("X-Auth-UserID"))
+		token := request.Header.Get("X-Auth-Token")
+		tokens = append(tokens, token)
+		if token != "new-token" {
+			writer.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(writer, prepareServerHTTPGet(true))
+	})
+
+	_, err := client.GetServer(dummyUUID)
+	if err != nil {
+		t.Errorf("GetServer returned an error %v", err)
+	}
+	assert.Equal(t, []string{"old-token", "new-token"}, tokens)
+	assert.Equal(t, 1, provider.refreshes)
+}
+
+func TestClient_CredentialsProviderRetriesOnce(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	provider := &rotatingCredentialsProvider{tokens: []string{"old-token"}}
+	client.cfg.Credentials = provider
+	uri := path.Join(apiServerBase, dummyUUID)
+	requests := 0
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		requests++
+		writer.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := client.GetServer(dummyUUID)
+	if requestError, ok := err.(RequestError); assert.True(t, ok) {
+		assert.Equal(t, http.StatusUnauthorized, requestError.StatusCode)
+	}
+	assert.Equal(t, 2, requests)
+}
+
+func TestStaticCredentialsProvider(t *testing.T) {
+	provider := NewStaticCredentialsProvider(dummyUUID, "token")
+	credentials, err := provider.Credentials()
+	assert.Nil(t, err)
+	assert.Equal(t, Credentials{UserUUID: dummyUUID, APIToken: "token"}, credentials)
+	assert.Nil(t, provider.Refresh())
+}
+
+func TestFileCredentialsProvider(t *testing.T) {
+	fileName, cleanup := writeTestProfileFile(t, dummyProfileFile)
+	defer cleanup()
+
+	provider, err := NewFileCredentialsProvider(fileName, "")
+	if err != nil {
+		t.Fatalf("NewFileCredentialsProvider returned an error %v", err)
+	}
+	credentials, err := provider.Credentials()
+	assert.Nil(t, err)
+	assert.Equal(t, Credentials{UserUUID: dummyUUID, APIToken: "default-token"}, credentials)
+
+	//The token is rotated in place
+	rotated := fmt.Sprintf("[default]\nuser_uuid = %s\napi_token = rotated-default-token\n", dummyUUID)
+	if err := ioutil.WriteFile(fileName, []byte(rotated), 0600); err != nil {
+		t.Fatal(err)
+	}
+	credentials, err = provider.Credentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "rotated-default-token", credentials.APIToken)
+
+	_, err = NewFileCredentialsProvider(fileName, "project-x")
+	assert.Error(t, err)
+}
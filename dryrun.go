@@ -0,0 +1,60 @@
+package gsclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+//RecordedRequest a mutating request that was recorded instead of being sent in dry-run mode
+type RecordedRequest struct {
+	Method string
+	URI    string
+	Body   json.RawMessage
+}
+
+//dryRunRecorder keeps the requests recorded in dry-run mode
+type dryRunRecorder struct {
+	mu       sync.Mutex
+	requests []RecordedRequest
+}
+
+//isMutating returns true for requests that change something, those are not sent in dry-run mode
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+//record records a request and returns a synthetic response for it.
+//The response contains a made-up object and request UUID, so create requests can be chained.
+func (d *dryRunRecorder) record(method, uri string, body []byte) []byte {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.requests = append(d.requests, RecordedRequest{
+		Method: method,
+		URI:    uri,
+		Body:   json.RawMessage(body),
+	})
+	n := len(d.requests)
+	return []byte(fmt.Sprintf(`{"object_uuid":"00000000-0000-4000-8000-%012d","request_uuid":"00000000-0000-4000-9000-%012d"}`, n, n))
+}
+
+//RecordedRequests returns the mutating requests recorded so far in dry-run mode
+func (c *Client) RecordedRequests() []RecordedRequest {
+	c.cfg.dryRun.mu.Lock()
+	defer c.cfg.dryRun.mu.Unlock()
+	requests := make([]RecordedRequest, len(c.cfg.dryRun.requests))
+	copy(requests, c.cfg.dryRun.requests)
+	return requests
+}
+
+//ResetRecordedRequests forgets the requests recorded so far in dry-run mode
+func (c *Client) ResetRecordedRequests() {
+	c.cfg.dryRun.mu.Lock()
+	defer c.cfg.dryRun.mu.Unlock()
+	c.cfg.dryRun.requests = nil
+}
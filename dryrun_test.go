@@ -0,0 +1,86 @@
+package gsclient
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	client.cfg.DryRun = true
+	uri := path.Join(apiServerBase, dummyUUID)
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareServerHTTPGet(true))
+	})
+	mux.HandleFunc(apiServerBase, func(writer http.ResponseWriter, request *http.Request) {
+		t.Errorf("%v request has been sent in dry-run mode", request.Method)
+	})
+	mux.HandleFunc("/requests/", func(writer http.ResponseWriter, request *http.Request) {
+		t.Error("request completion has been waited for in dry-run mode")
+	})
+
+	//GET requests are sent as usual
+	res, err := client.GetServer(dummyUUID)
+	if err != nil {
+		t.Errorf("GetServer returned an error %v", err)
+	}
+	assert.Equal(t, dummyUUID, res.Properties.ObjectUUID)
+
+	created, err := client.CreateServer(ServerCreateRequest{Name: "test", Memory: 1, Cores: 1, LocationUUID: dummyUUID})
+	if err != nil {
+		t.Errorf("CreateServer returned an error %v", err)
+	}
+	assert.True(t, isValidUUID(created.ObjectUUID))
+	assert.True(t, isValidUUID(created.RequestUUID))
+
+	err = client.StopServer(dummyUUID)
+	if err != nil {
+		t.Errorf("StopServer returned an error %v", err)
+	}
+	err = client.DeleteServer(dummyUUID)
+	if err != nil {
+		t.Errorf("DeleteServer returned an error %v", err)
+	}
+
+	recorded := client.RecordedRequests()
+	if assert.Equal(t, 3, len(recorded)) {
+		assert.Equal(t, http.MethodPost, recorded[0].Method)
+		assert.Equal(t, apiServerBase, recorded[0].URI)
+		assert.JSONEq(t, fmt.Sprintf(`{"name":"test","memory":1,"cores":1,"location_uuid":"%s"}`, dummyUUID), string(recorded[0].Body))
+		assert.Equal(t, http.MethodPatch, recorded[1].Method)
+		assert.Equal(t, path.Join(uri, "power"), recorded[1].URI)
+		assert.JSONEq(t, `{"power":false}`, string(recorded[1].Body))
+		assert.Equal(t, RecordedRequest{Method: http.MethodDelete, URI: uri, Body: nil}, recorded[2])
+	}
+
+	client.ResetRecordedRequests()
+	assert.Equal(t, 0, len(client.RecordedRequests()))
+}
+
+func TestClient_DryRunRedactsLog(t *testing.T) {
+	server, client, _ := setupTestClient()
+	defer server.Close()
+	client.cfg.DryRun = true
+	var log bytes.Buffer
+	client.cfg.logger.Out = &log
+	client.cfg.logger.Level = logrus.DebugLevel
+
+	_, err := client.CreateStorage(StorageCreateRequest{
+		Name:     "test",
+		Capacity: 10,
+		Template: &StorageTemplate{TemplateUUID: dummyUUID, Password: "secret-password", PasswordType: "plain"},
+	})
+	if err != nil {
+		t.Errorf("CreateStorage returned an error %v", err)
+	}
+	assert.Contains(t, log.String(), redactedValue)
+	assert.NotContains(t, log.String(), "secret-password")
+}
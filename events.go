@@ -0,0 +1,212 @@
+package gsclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+//EventList JSON struct of a list of an object's events
+type EventList struct {
+	List []EventProperties `json:"events"`
+}
+
+//Event JSON struct of an event of an object of any kind
+type Event struct {
+	Properties EventProperties `json:"event"`
+}
+
+//EventProperties JSON struct of properties of an event
+type EventProperties struct {
+	ObjectType    string `json:"object_type"`
+	RequestUUID   string `json:"request_uuid"`
+	ObjectUUID    string `json:"object_uuid"`
+	Activity      string `json:"activity"`
+	RequestType   string `json:"request_type"`
+	RequestStatus string `json:"request_status"`
+	Change        string `json:"change"`
+	Timestamp     string `json:"timestamp"`
+	UserUUID      string `json:"user_uuid"`
+}
+
+//Time parses the timestamp of the event
+func (e EventProperties) Time() (time.Time, error) {
+	return time.Parse(time.RFC3339, e.Timestamp)
+}
+
+//FieldChange the change of a field of an object, before and after are the decoded JSON values
+type FieldChange struct {
+	Field  string
+	Before interface{}
+	After  interface{}
+}
+
+//ParseChange parses the change of the event into the changed fields, sorted by field.
+//The change is parsed if it's a JSON object whose values are either a [before, after] array
+//or an object with the keys "old" and "new". ok is false if the change has another format,
+//the change is only available as text then.
+func (e EventProperties) ParseChange() (changes []FieldChange, ok bool) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(e.Change), &fields); err != nil || len(fields) == 0 {
+		return nil, false
+	}
+	for field, raw := range fields {
+		var pair []interface{}
+		if err := json.Unmarshal(raw, &pair); err == nil && len(pair) == 2 {
+			changes = append(changes, FieldChange{Field: field, Before: pair[0], After: pair[1]})
+			continue
+		}
+		var oldNew map[string]interface{}
+		if err := json.Unmarshal(raw, &oldNew); err != nil || len(oldNew) != 2 {
+			return nil, false
+		}
+		before, hasOld := oldNew["old"]
+		after, hasNew := oldNew["new"]
+		if !hasOld || !hasNew {
+			return nil, false
+		}
+		changes = append(changes, FieldChange{Field: field, Before: before, After: after})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes, true
+}
+
+//EventFilter restricts the events of the event timeline, empty fields don't restrict the events
+type EventFilter struct {
+	//Since only events at this time or later
+	Since time.Time
+	//Until only events before this time
+	Until time.Time
+	//UserUUID only events caused by this user
+	UserUUID string
+	//RequestType only events of requests of this type, e.g. "POST"
+	RequestType string
+	//RequestStatus only events of requests with this status
+	RequestStatus string
+	//Kinds only events of objects of these kinds, all kinds with events if empty
+	Kinds []ObjectKind
+}
+
+//matches reports whether an event with the parsed time t passes the filter
+func (f EventFilter) matches(event EventProperties, t time.Time) bool {
+	if f.UserUUID != "" && event.UserUUID != f.UserUUID {
+		return false
+	}
+	if f.RequestType != "" && event.RequestType != f.RequestType {
+		return false
+	}
+	if f.RequestStatus != "" && event.RequestStatus != f.RequestStatus {
+		return false
+	}
+	if !f.Since.IsZero() && (t.IsZero() || t.Before(f.Since)) {
+		return false
+	}
+	if !f.Until.IsZero() && (t.IsZero() || !t.Before(f.Until)) {
+		return false
+	}
+	return true
+}
+
+//TimelineEvent an event of the event timeline together with the object it belongs to
+type TimelineEvent struct {
+	Kind       ObjectKind
+	ObjectName string
+	//Time the parsed timestamp of the event, zero if the timestamp cannot be parsed
+	Time       time.Time
+	Properties EventProperties
+}
+
+//GetEventTimeline gets the events of all objects of the account merged into chronological order.
+//Events with the same time are ordered by the UUID of their request. Events whose timestamp cannot be parsed
+//come first and are left out if the filter has a time range.
+//
+//The events of every object are fetched by a request of their own, the requests are sent concurrently,
+//see Config.MaxConcurrency. If the events of some objects cannot be fetched, the error is a BulkError keyed by
+//the UUID of the object, or by the kind if the list of objects of the kind cannot be fetched, and the timeline
+//holds the events of all other objects.
+func (c *Client) GetEventTimeline(filter EventFilter) ([]TimelineEvent, error) {
+	kinds := filter.Kinds
+	if len(kinds) == 0 {
+		for _, kind := range ObjectKinds() {
+			if objectKinds[kind].events {
+				kinds = append(kinds, kind)
+			}
+		}
+	}
+	for _, kind := range kinds {
+		k, err := lookupObjectKind(kind)
+		if err != nil {
+			return nil, err
+		}
+		if !k.events {
+			return nil, fmt.Errorf("objects of kind %s have no events", kind)
+		}
+	}
+
+	lists := make([][]Object, len(kinds))
+	errs := c.forEachConcurrently(len(kinds), func(i int) (err error) {
+		lists[i], err = c.GetObjectList(kinds[i])
+		return err
+	})
+	ids := make([]string, len(kinds))
+	failed := BulkError{}
+	for i, err := range errs {
+		ids[i] = string(kinds[i])
+		if err != nil {
+			failed[ids[i]] = err
+		}
+	}
+	var objects []Object
+	for _, list := range lists {
+		objects = append(objects, list...)
+	}
+
+	events := make([][]TimelineEvent, len(objects))
+	ids = make([]string, len(objects))
+	for i, object := range objects {
+		ids[i] = object.UUID()
+	}
+	errs = c.forEachConcurrently(len(objects), func(i int) error {
+		object := objects[i]
+		list, err := c.GetObjectEventList(object.Kind(), object.UUID())
+		if err != nil {
+			return err
+		}
+		for _, event := range list {
+			t, _ := event.Properties.Time()
+			if !filter.matches(event.Properties, t) {
+				continue
+			}
+			events[i] = append(events[i], TimelineEvent{
+				Kind:       object.Kind(),
+				ObjectName: object.Name(),
+				Time:       t,
+				Properties: event.Properties,
+			})
+		}
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			failed[ids[i]] = err
+		}
+	}
+
+	var timeline []TimelineEvent
+	for _, list := range events {
+		timeline = append(timeline, list...)
+	}
+	sort.SliceStable(timeline, func(i, j int) bool {
+		if !timeline[i].Time.Equal(timeline[j].Time) {
+			return timeline[i].Time.Before(timeline[j].Time)
+		}
+		return timeline[i].Properties.RequestUUID < timeline[j].Properties.RequestUUID
+	})
+	if len(failed) > 0 {
+		return timeline, failed
+	}
+	return timeline, nil
+}
@@ -0,0 +1,112 @@
+package gsclient
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventProperties_Time(t *testing.T) {
+	event := EventProperties{Timestamp: dummyTime}
+	eventTime, err := event.Time()
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2018, 4, 28, 9, 47, 41, 0, time.UTC), eventTime)
+
+	_, err = EventProperties{Timestamp: "yesterday"}.Time()
+	assert.Error(t, err)
+}
+
+func TestEventProperties_ParseChange(t *testing.T) {
+	changes, ok := EventProperties{Change: `{"name": ["web", "web-01"], "cores": {"old": 2, "new": 4}}`}.ParseChange()
+	assert.True(t, ok)
+	assert.Equal(t, []FieldChange{
+		{Field: "cores", Before: float64(2), After: float64(4)},
+		{Field: "name", Before: "web", After: "web-01"},
+	}, changes)
+
+	for _, change := range []string{"", "change note", `{}`, `{"name": "web"}`, `{"name": ["web"]}`, `{"cores": {"old": 2}}`} {
+		_, ok = EventProperties{Change: change}.ParseChange()
+		assert.False(t, ok, change)
+	}
+}
+
+func TestEventFilter_matches(t *testing.T) {
+	event := EventProperties{UserUUID: dummyUUID, RequestType: "PATCH", RequestStatus: "done"}
+	eventTime := time.Date(2018, 4, 28, 9, 47, 41, 0, time.UTC)
+	testCases := []struct {
+		filter  EventFilter
+		matches bool
+	}{
+		{EventFilter{}, true},
+		{EventFilter{UserUUID: dummyUUID, RequestType: "PATCH", RequestStatus: "done"}, true},
+		{EventFilter{UserUUID: "other"}, false},
+		{EventFilter{RequestType: "DELETE"}, false},
+		{EventFilter{RequestStatus: "failed"}, false},
+		{EventFilter{Since: eventTime}, true},
+		{EventFilter{Since: eventTime.Add(time.Second)}, false},
+		{EventFilter{Until: eventTime}, false},
+		{EventFilter{Until: eventTime.Add(time.Second)}, true},
+	}
+	for _, test := range testCases {
+		assert.Equal(t, test.matches, test.filter.matches(event, eventTime), "%+v", test.filter)
+	}
+	assert.True(t, EventFilter{}.matches(event, time.Time{}))
+	assert.False(t, EventFilter{Since: eventTime}.matches(event, time.Time{}))
+}
+
+func TestClient_GetEventTimeline(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	mux.HandleFunc(apiServerBase, func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"servers": {"a": {"object_uuid": "a", "name": "web"}}}`)
+	})
+	mux.HandleFunc(apiStorageBase, func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"storages": {"b": {"object_uuid": "b", "name": "disk"}, "c": {"object_uuid": "c", "name": "broken"}}}`)
+	})
+	mux.HandleFunc(path.Join(apiServerBase, "a", "events"), func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, `{"events": [
+			{"request_uuid": "r3", "object_uuid": "a", "request_type": "PATCH", "timestamp": "2019-01-03T10:00:00Z"},
+			{"request_uuid": "r1", "object_uuid": "a", "request_type": "POST", "timestamp": "2019-01-01T10:00:00Z"}
+		]}`)
+	})
+	mux.HandleFunc(path.Join(apiStorageBase, "b", "events"), func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"events": [
+			{"request_uuid": "r2", "object_uuid": "b", "request_type": "PATCH", "timestamp": "2019-01-02T10:00:00Z"}
+		]}`)
+	})
+	mux.HandleFunc(path.Join(apiStorageBase, "c", "events"), func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+	})
+
+	filter := EventFilter{Kinds: []ObjectKind{KindServer, KindStorage}}
+	timeline, err := client.GetEventTimeline(filter)
+	if bulkErr, ok := err.(BulkError); assert.True(t, ok) {
+		assert.Equal(t, 1, len(bulkErr))
+		assert.Error(t, bulkErr["c"])
+	}
+	var requests []string
+	for _, event := range timeline {
+		requests = append(requests, event.Properties.RequestUUID)
+	}
+	assert.Equal(t, []string{"r1", "r2", "r3"}, requests)
+	assert.Equal(t, KindStorage, timeline[1].Kind)
+	assert.Equal(t, "disk", timeline[1].ObjectName)
+	assert.Equal(t, time.Date(2019, 1, 2, 10, 0, 0, 0, time.UTC), timeline[1].Time)
+
+	filter.RequestType = "PATCH"
+	filter.Since = time.Date(2019, 1, 2, 12, 0, 0, 0, time.UTC)
+	timeline, _ = client.GetEventTimeline(filter)
+	if assert.Equal(t, 1, len(timeline)) {
+		assert.Equal(t, "r3", timeline[0].Properties.RequestUUID)
+	}
+
+	_, err = client.GetEventTimeline(EventFilter{Kinds: []ObjectKind{KindLocation}})
+	assert.Error(t, err)
+	_, err = client.GetEventTimeline(EventFilter{Kinds: []ObjectKind{"unknown"}})
+	assert.Error(t, err)
+}
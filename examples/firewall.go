@@ -8,14 +8,10 @@ import (
 )
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		false,
-	)
+	config, err := gsclient.LoadConfiguration("", false)
+	if err != nil {
+		log.Fatal("Loading configuration has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
@@ -10,14 +10,10 @@ import (
 const locationUUID = "45ed677b-3702-4b36-be2a-a2eab9827950"
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-	)
+	config, err := gsclient.LoadConfiguration("", true)
+	if err != nil {
+		logrus.Fatal("Loading configuration has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	logrus.Info("gridscale client configured")
 
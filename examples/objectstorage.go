@@ -9,14 +9,10 @@ import (
 )
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-	)
+	config, err := gsclient.LoadConfiguration("", true)
+	if err != nil {
+		log.Fatal("Loading configuration has failed with error", err)
+	}
 	client := gsclient.NewClient(config)
 	log.Info("gridscale client configured")
 
@@ -11,16 +11,6 @@ import (
 const locationUUID = "45ed677b-3702-4b36-be2a-a2eab9827950"
 const webServerFirewallTemplateUUID = "82aa235b-61ba-48ca-8f47-7060a0435de7"
 
-type serviceType string
-
-const (
-	serverType   serviceType = "server"
-	storageType  serviceType = "storage"
-	networkType  serviceType = "network"
-	ipType       serviceType = "ip"
-	isoImageType serviceType = "isoimage"
-)
-
 //enhancedClient inherits all methods from gsclient.Client
 //We need this to implement a new additional method
 type enhancedClient struct {
@@ -28,14 +18,10 @@ type enhancedClient struct {
 }
 
 func main() {
-	uuid := os.Getenv("GRIDSCALE_UUID")
-	token := os.Getenv("GRIDSCALE_TOKEN")
-	config := gsclient.NewConfiguration(
-		"https://api.gridscale.io",
-		uuid,
-		token,
-		true,
-	)
+	config, err := gsclient.LoadConfiguration("", true)
+	if err != nil {
+		log.Fatal("Loading configuration has failed with error", err)
+	}
 	client := enhancedClient{
 		gsclient.NewClient(config),
 	}
@@ -57,7 +43,7 @@ func main() {
 	log.WithFields(log.Fields{
 		"server_uuid": cServer.ObjectUUID,
 	}).Info("Server successfully created")
-	defer client.deleteService(serverType, cServer.ObjectUUID)
+	defer client.deleteService(gsclient.KindServer, cServer.ObjectUUID)
 
 	//get a server to interact with
 	server, err := client.GetServer(cServer.ObjectUUID)
@@ -123,7 +109,7 @@ func main() {
 	log.WithFields(log.Fields{
 		"storage_uuid": cStorage.ObjectUUID,
 	}).Info("Storage successfully created")
-	defer client.deleteService(storageType, cStorage.ObjectUUID)
+	defer client.deleteService(gsclient.KindStorage, cStorage.ObjectUUID)
 
 	cNetwork, err := client.CreateNetwork(gsclient.NetworkCreateRequest{
 		Name:         "go-client-network",
@@ -136,7 +122,7 @@ func main() {
 	log.WithFields(log.Fields{
 		"network_uuid": cNetwork.ObjectUUID,
 	}).Info("Network successfully created")
-	defer client.deleteService(networkType, cNetwork.ObjectUUID)
+	defer client.deleteService(gsclient.KindNetwork, cNetwork.ObjectUUID)
 
 	cIP, err := client.CreateIP(gsclient.IPCreateRequest{
 		Name:         "go-client-ip",
@@ -150,7 +136,7 @@ func main() {
 	log.WithFields(log.Fields{
 		"IP_uuid": cIP.ObjectUUID,
 	}).Info("IP successfully created")
-	defer client.deleteService(ipType, cIP.ObjectUUID)
+	defer client.deleteService(gsclient.KindIP, cIP.ObjectUUID)
 
 	cISOimage, err := client.CreateISOImage(gsclient.ISOImageCreateRequest{
 		Name:         "go-client-iso",
@@ -164,7 +150,7 @@ func main() {
 	log.WithFields(log.Fields{
 		"isoimage_uuid": cISOimage.ObjectUUID,
 	}).Info("ISO-image successfully created")
-	defer client.deleteService(isoImageType, cISOimage.ObjectUUID)
+	defer client.deleteService(gsclient.KindISOImage, cISOimage.ObjectUUID)
 
 	//Attach storage, network, IP, and ISO-image to a server
 	err = client.LinkStorage(server.Properties.ObjectUUID, cStorage.ObjectUUID, false)
@@ -173,7 +159,7 @@ func main() {
 		return
 	}
 	log.Info("Storage successfully attached")
-	defer client.unlinkService(storageType, server.Properties.ObjectUUID, cStorage.ObjectUUID)
+	defer client.unlinkService(gsclient.KindStorage, server.Properties.ObjectUUID, cStorage.ObjectUUID)
 
 	err = client.LinkNetwork(
 		server.Properties.ObjectUUID,
@@ -189,7 +175,7 @@ func main() {
 		return
 	}
 	log.Info("Network successfully linked")
-	defer client.unlinkService(networkType, server.Properties.ObjectUUID, cNetwork.ObjectUUID)
+	defer client.unlinkService(gsclient.KindNetwork, server.Properties.ObjectUUID, cNetwork.ObjectUUID)
 
 	err = client.LinkIP(server.Properties.ObjectUUID, cIP.ObjectUUID)
 	if err != nil {
@@ -197,7 +183,7 @@ func main() {
 		return
 	}
 	log.Info("IP successfully linked")
-	defer client.unlinkService(ipType, server.Properties.ObjectUUID, cIP.ObjectUUID)
+	defer client.unlinkService(gsclient.KindIP, server.Properties.ObjectUUID, cIP.ObjectUUID)
 
 	err = client.LinkIsoImage(server.Properties.ObjectUUID, cISOimage.ObjectUUID)
 	if err != nil {
@@ -205,85 +191,53 @@ func main() {
 		return
 	}
 	log.Info("ISO-image successfully linked")
-	defer client.unlinkService(isoImageType, server.Properties.ObjectUUID, cISOimage.ObjectUUID)
+	defer client.unlinkService(gsclient.KindISOImage, server.Properties.ObjectUUID, cISOimage.ObjectUUID)
 
 	log.Info("Unlink and delete: press 'Enter' to continue...")
 	bufio.NewReader(os.Stdin).ReadBytes('\n')
 }
 
-func (c *enhancedClient) deleteService(serviceType serviceType, id string) {
-	switch serviceType {
-	case serverType:
+func (c *enhancedClient) deleteService(kind gsclient.ObjectKind, id string) {
+	if kind == gsclient.KindServer {
 		//turn off server before deleting
 		err := c.StopServer(id)
 		if err != nil {
 			log.Error("Stop server has failed with error", err)
 			return
 		}
-		err = c.DeleteServer(id)
-		if err != nil {
-			log.Error("Delete server has failed with error", err)
-			return
-		}
-		log.Info("Server successfully deleted")
-	case storageType:
-		err := c.DeleteStorage(id)
-		if err != nil {
-			log.Error("Delete storage has failed with error", err)
-			return
-		}
-		log.Info("Storage successfully deleted")
-	case networkType:
-		err := c.DeleteNetwork(id)
-		if err != nil {
-			log.Error("Delete network has failed with error", err)
-			return
-		}
-		log.Info("Network successfully deleted")
-	case ipType:
-		err := c.DeleteIP(id)
-		if err != nil {
-			log.Error("Delete IP has failed with error", err)
-			return
-		}
-		log.Info("IP successfully deleted")
-	case isoImageType:
-		err := c.DeleteISOImage(id)
-		if err != nil {
-			log.Error("Delete ISO-image has failed with error", err)
-			return
-		}
-		log.Info("ISO-image successfully deleted")
-	default:
-		log.Error("Unknown service type")
+	}
+	err := c.DeleteObject(kind, id)
+	if err != nil {
+		log.Errorf("Delete %s has failed with error %v", kind, err)
 		return
 	}
+	log.Infof("%s successfully deleted", kind)
 }
 
-func (c *enhancedClient) unlinkService(serviceType serviceType, serverID, serviceID string) {
-	switch serviceType {
-	case storageType:
+func (c *enhancedClient) unlinkService(kind gsclient.ObjectKind, serverID, serviceID string) {
+	switch kind {
+	case gsclient.KindStorage:
 		err := c.UnlinkStorage(serverID, serviceID)
 		if err != nil {
 			log.Error("Unlink storage has failed with error", err)
 			return
 		}
 		log.Info("Storage successfully unlinked")
-	case networkType:
+	case gsclient.KindNetwork:
 		err := c.UnlinkNetwork(serverID, serviceID)
 		if err != nil {
 			log.Error("Unlink network has failed with error", err)
 			return
 		}
 		log.Info("Network successfully unlinked")
-	case ipType:
+	case gsclient.KindIP:
 		err := c.UnlinkIP(serverID, serviceID)
 		if err != nil {
 			log.Error("Unlink IP has failed with error", err)
 			return
 		}
 		log.Info("IP successfully unlinked")
-	case isoImageType:
+	case gsclient.KindISOImage:
 		err := c.UnlinkIsoImage(serverID, serviceID)
 		if err != nil {
 			log.Error("Unlink ISO-image has failed with error", err)
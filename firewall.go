@@ -84,28 +84,14 @@ type FirewallUpdateRequest struct {
 	Rules  FirewallRules `json:"rules,omitempty"`
 }
 
-//FirewallEventList is JSON struct of a list of firewall's events
-type FirewallEventList struct {
-	List []FirewallEventProperties `json:"events"`
-}
+//FirewallEventList the list of events of a firewall, it's the same type as EventList
+type FirewallEventList = EventList
 
-//FirewallEvent is JSOn struct of a single firewall's event
-type FirewallEvent struct {
-	Properties FirewallEventProperties `json:"event"`
-}
+//FirewallEvent a single event of a firewall, it's the same type as Event
+type FirewallEvent = Event
 
-//FirewallEventProperties is JSON struct of a firewall's properties
-type FirewallEventProperties struct {
-	ObjectType    string `json:"object_type"`
-	RequestUUID   string `json:"request_uuid"`
-	ObjectUUID    string `json:"object_uuid"`
-	Activity      string `json:"activity"`
-	RequestType   string `json:"request_type"`
-	RequestStatus string `json:"request_status"`
-	Change        string `json:"change"`
-	Timestamp     string `json:"timestamp"`
-	UserUUID      string `json:"user_uuid"`
-}
+//FirewallEventProperties the properties of an event of a firewall, it's the same type as EventProperties
+type FirewallEventProperties = EventProperties
 
 //GetFirewallList gets a list of available firewalls
 func (c *Client) GetFirewallList() ([]Firewall, error) {
@@ -119,9 +105,68 @@ func (c *Client) GetFirewallList() ([]Firewall, error) {
 	for _, properties := range response.List {
 		firewalls = append(firewalls, Firewall{Properties: properties})
 	}
+	sortList(firewalls, SortByName, func(i int) listEntry {
+		return firewalls[i].listEntry()
+	})
 	return firewalls, err
 }
 
+//GetFirewallListWithOptions gets a list of available firewalls matching the given options
+func (c *Client) GetFirewallListWithOptions(opts ListOptions) ([]Firewall, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	firewalls, err := c.GetFirewallList()
+	var filtered []Firewall
+	for _, firewall := range firewalls {
+		if match(firewall.listEntry()) {
+			filtered = append(filtered, firewall)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetFirewallMap gets the firewalls keyed by their UUID
+func (c *Client) GetFirewallMap() (map[string]Firewall, error) {
+	firewalls, err := c.GetFirewallList()
+	firewallMap := make(map[string]Firewall, len(firewalls))
+	for _, firewall := range firewalls {
+		firewallMap[firewall.Properties.ObjectUUID] = firewall
+	}
+	return firewallMap, err
+}
+
+//GetFirewallByName gets a firewall by its name. If no firewall has the name an ObjectNotFoundError is returned,
+//if several have it an AmbiguousNameError.
+func (c *Client) GetFirewallByName(name string) (Firewall, error) {
+	firewalls, err := c.GetFirewallList()
+	if err != nil {
+		return Firewall{}, err
+	}
+	i, err := findByName("firewall", name, len(firewalls), func(i int) listEntry {
+		return firewalls[i].listEntry()
+	})
+	if err != nil {
+		return Firewall{}, err
+	}
+	return firewalls[i], nil
+}
+
+//listEntry returns the attributes list options are applied to
+func (f Firewall) listEntry() listEntry {
+	return listEntry{
+		uuid:       f.Properties.ObjectUUID,
+		name:       f.Properties.Name,
+		createTime: f.Properties.CreateTime,
+		status:     f.Properties.Status,
+		labels:     f.Properties.Labels,
+	}
+}
+
 //GetFirewall gets a specific firewall based on given id
 func (c *Client) GetFirewall(id string) (Firewall, error) {
 	r := Request{
@@ -159,6 +204,34 @@ func (c *Client) UpdateFirewall(id string, body FirewallUpdateRequest) error {
 	return r.execute(*c, nil)
 }
 
+//AddFirewallLabels adds labels to a firewall. Labels with a key the firewall already has replace the existing ones
+func (c *Client) AddFirewallLabels(id string, labels ...string) error {
+	firewall, err := c.GetFirewall(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiFirewallBase, id), firewall.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdateFirewall(id, FirewallUpdateRequest{
+			Rules:  firewall.Properties.Rules,
+			Labels: labels,
+		})
+	})
+}
+
+//RemoveFirewallLabels removes the labels with the given keys from a firewall
+func (c *Client) RemoveFirewallLabels(id string, keys ...string) error {
+	firewall, err := c.GetFirewall(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiFirewallBase, id), firewall.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdateFirewall(id, FirewallUpdateRequest{
+			Rules:  firewall.Properties.Rules,
+			Labels: labels,
+		})
+	})
+}
+
 //DeleteFirewall delete a specific firewall
 func (c *Client) DeleteFirewall(id string) error {
 	r := Request{
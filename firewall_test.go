@@ -25,6 +25,66 @@ func TestClient_GetFirewallList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockFirewall()), fmt.Sprintf("%v", response))
 }
 
+func TestClient_GetFirewallListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiFirewallBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareFirewallListHTTPGet())
+	})
+	res, err := client.GetFirewallListWithOptions(ListOptions{NamePattern: "*", LabelSelector: "!deprecated"})
+	if err != nil {
+		t.Errorf("GetFirewallListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetFirewallListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetFirewallListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetFirewallListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetFirewallMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiFirewallBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareFirewallListHTTPGet())
+	})
+	res, err := client.GetFirewallMap()
+	if err != nil {
+		t.Errorf("GetFirewallMap returned an error %v", err)
+	}
+	mock := getMockFirewall()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
+func TestClient_GetFirewallByName(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiFirewallBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareFirewallListHTTPGet())
+	})
+	mock := getMockFirewall()
+	res, err := client.GetFirewallByName(mock.Properties.Name)
+	if err != nil {
+		t.Errorf("GetFirewallByName returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res))
+
+	_, err = client.GetFirewallByName("unknown")
+	assert.IsType(t, ObjectNotFoundError{}, err)
+}
+
 func TestClient_GetFirewall(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -101,6 +161,30 @@ func TestClient_UpdateFirewall(t *testing.T) {
 	}
 }
 
+func TestClient_AddFirewallLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiFirewallBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareFirewallHTTPGet())
+	err := client.AddFirewallLabels(dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddFirewallLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"label", "env=prod"}}, *updates)
+}
+
+func TestClient_RemoveFirewallLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiFirewallBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareFirewallHTTPGet())
+	err := client.RemoveFirewallLabels(dummyUUID, "label")
+	if err != nil {
+		t.Errorf("RemoveFirewallLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{}}, *updates)
+}
+
 func TestClient_DeleteFirewall(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
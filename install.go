@@ -0,0 +1,214 @@
+package gsclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+//DefaultISOImageTimeout is the time the download of an ISO-Image may take if InstallOptions.ISOImageTimeout isn't set
+const DefaultISOImageTimeout = 30 * time.Minute
+
+//DefaultInstallInterval is the time between two checks of an installation if InstallOptions.Interval isn't set
+const DefaultInstallInterval = 10 * time.Second
+
+//isoImageActive is the status of an ISO-Image which has been downloaded
+const isoImageActive = "active"
+
+//InstallFinished reports whether the installation on a server has finished
+type InstallFinished func(ctx context.Context, server Server) (bool, error)
+
+//InstallOptions options of installing a server from an ISO-Image
+type InstallOptions struct {
+	//ISOImageUUID an existing ISO-Image, the ISO-Image is created from ISOImage if empty
+	ISOImageUUID string
+	ISOImage     ISOImageCreateRequest
+	//ISOImageTimeout time the download of the ISO-Image may take, DefaultISOImageTimeout if zero
+	ISOImageTimeout time.Duration
+	//Finished is called until it reports the installation as finished. If nil, the installation has
+	//finished once the server has powered itself off or Timeout has passed.
+	Finished InstallFinished
+	//Timeout time the installation may take, no limit if zero. It's an error to reach it if Finished is set.
+	Timeout time.Duration
+	//Interval time between two checks of the installation, DefaultInstallInterval if zero
+	Interval time.Duration
+	//Shutdown policy of shutting down the server before and after the installation
+	Shutdown ShutdownPolicy
+	//DeleteISOImage deletes the ISO-Image after the installation if it has been created for it
+	DeleteISOImage bool
+	//Start starts the server from its storage after the installation
+	Start bool
+}
+
+//Installation what an installation has done
+type Installation struct {
+	ISOImageUUID string
+	//ISOImageCreated the ISO-Image has been created for the installation
+	ISOImageCreated bool
+	//StorageUUID the storage the server boots from after the installation
+	StorageUUID string
+	//TimedOut the installation has been assumed finished because Timeout has passed
+	TimedOut bool
+}
+
+//InstallServer installs a server from an ISO-Image. The ISO-Image is created unless an existing one is given
+//and the installation waits until it has been downloaded. A running server is shut down, the ISO-Image is
+//linked as boot device and the server is started. Once the installation has finished, the server is shut
+//down, the ISO-Image is unlinked and the boot storage of the server, or else its first storage, becomes the
+//boot device again.
+//
+//If a step fails or ctx is cancelled, the installation stops and the server is left as it is, so it can be
+//inspected. The returned installation holds the objects used so far.
+func (c *Client) InstallServer(ctx context.Context, id string, opts InstallOptions) (Installation, error) {
+	server, err := c.GetServer(id)
+	if err != nil {
+		return Installation{}, err
+	}
+	var installation Installation
+	storages := server.Properties.Relations.Storages
+	if len(storages) == 0 {
+		return installation, fmt.Errorf("server %v has no storage to install to", id)
+	}
+	installation.StorageUUID = storages[0].ObjectUUID
+	for _, storage := range storages {
+		if storage.BootDevice {
+			installation.StorageUUID = storage.ObjectUUID
+			break
+		}
+	}
+
+	installation.ISOImageUUID = opts.ISOImageUUID
+	if installation.ISOImageUUID == "" {
+		response, err := c.CreateISOImage(opts.ISOImage)
+		installation.ISOImageUUID = response.ObjectUUID
+		installation.ISOImageCreated = response.ObjectUUID != ""
+		if err != nil {
+			return installation, fmt.Errorf("creating the ISO-Image failed: %v", err)
+		}
+	}
+	isoImageID := installation.ISOImageUUID
+	isoImage, err := c.waitForISOImage(ctx, isoImageID, opts)
+	if err != nil {
+		return installation, err
+	}
+
+	if server.Properties.Power {
+		c.cfg.logger.Infof("Shutting down server %v for the installation", id)
+		if err := c.ShutdownServerWithPolicy(id, opts.Shutdown); err != nil {
+			return installation, fmt.Errorf("shutting down server %v failed: %v", id, err)
+		}
+	}
+	linked := false
+	for _, relation := range server.Properties.Relations.IsoImages {
+		linked = linked || relation.ObjectUUID == isoImageID
+	}
+	if !linked {
+		if err := c.LinkIsoImage(id, isoImageID); err != nil {
+			return installation, fmt.Errorf("linking ISO-Image %v failed: %v", isoImageID, err)
+		}
+	}
+	err = c.UpdateServerIsoImage(id, isoImageID, ServerIsoImageRelationUpdateRequest{
+		BootDevice: true,
+		Name:       isoImage.Properties.Name,
+	})
+	if err != nil {
+		return installation, fmt.Errorf("booting from ISO-Image %v failed: %v", isoImageID, err)
+	}
+	if err := c.StartServer(id); err != nil {
+		return installation, fmt.Errorf("starting server %v failed: %v", id, err)
+	}
+
+	c.cfg.logger.Infof("Waiting for the installation on server %v to finish", id)
+	if installation.TimedOut, err = c.waitForInstallation(ctx, id, opts); err != nil {
+		return installation, err
+	}
+
+	if err := c.ShutdownServerWithPolicy(id, opts.Shutdown); err != nil {
+		return installation, fmt.Errorf("shutting down server %v after the installation failed: %v", id, err)
+	}
+	if err := c.UnlinkIsoImage(id, isoImageID); err != nil {
+		return installation, fmt.Errorf("unlinking ISO-Image %v failed: %v", isoImageID, err)
+	}
+	err = c.UpdateServerStorage(id, installation.StorageUUID, ServerStorageRelationUpdateRequest{BootDevice: true})
+	if err != nil {
+		return installation, fmt.Errorf("booting from storage %v failed: %v", installation.StorageUUID, err)
+	}
+	if installation.ISOImageCreated && opts.DeleteISOImage {
+		if err := c.DeleteISOImage(isoImageID); err != nil {
+			return installation, fmt.Errorf("deleting ISO-Image %v failed: %v", isoImageID, err)
+		}
+	}
+	if opts.Start {
+		if err := c.StartServer(id); err != nil {
+			return installation, fmt.Errorf("starting server %v after the installation failed: %v", id, err)
+		}
+	}
+	return installation, nil
+}
+
+//waitForISOImage waits until an ISO-Image has been downloaded
+func (c *Client) waitForISOImage(ctx context.Context, id string, opts InstallOptions) (ISOImage, error) {
+	timeout := opts.ISOImageTimeout
+	if timeout <= 0 {
+		timeout = DefaultISOImageTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		isoImage, err := c.GetISOImage(id)
+		if err != nil {
+			return isoImage, err
+		}
+		if isoImage.Properties.Status == isoImageActive {
+			return isoImage, nil
+		}
+		c.cfg.logger.Debugf("ISO-Image %v has status %v", id, isoImage.Properties.Status)
+		if err := sleepContext(ctx, installInterval(opts)); err != nil {
+			return isoImage, fmt.Errorf("ISO-Image %v isn't ready, status %v: %v", id, isoImage.Properties.Status, err)
+		}
+	}
+}
+
+//waitForInstallation waits until the installation on a server has finished, timedOut is set if it's
+//assumed finished because the timeout has passed
+func (c *Client) waitForInstallation(ctx context.Context, id string, opts InstallOptions) (timedOut bool, err error) {
+	var deadline <-chan time.Time
+	if opts.Timeout > 0 {
+		timer := time.NewTimer(opts.Timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+	for {
+		server, err := c.GetServer(id)
+		if err != nil {
+			return false, err
+		}
+		finished := !server.Properties.Power
+		if opts.Finished != nil {
+			if finished, err = opts.Finished(ctx, server); err != nil {
+				return false, fmt.Errorf("checking the installation on server %v failed: %v", id, err)
+			}
+		}
+		if finished {
+			return false, nil
+		}
+		select {
+		case <-time.After(installInterval(opts)):
+		case <-deadline:
+			if opts.Finished != nil {
+				return false, fmt.Errorf("timeout reached when waiting for the installation on server %v", id)
+			}
+			return true, nil
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+}
+
+//installInterval returns the time between two checks of an installation
+func installInterval(opts InstallOptions) time.Duration {
+	if opts.Interval <= 0 {
+		return DefaultInstallInterval
+	}
+	return opts.Interval
+}
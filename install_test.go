@@ -0,0 +1,105 @@
+package gsclient
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_InstallServer(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, "/objects/servers/server-1", `{"server": {"object_uuid": "server-1",
+		"relations": {"storages": [{"object_uuid": "storage-1"}, {"object_uuid": "storage-2", "bootdevice": true}]}}}`)
+	api.respond(http.MethodPost, apiISOBase, `{"object_uuid": "iso-1", "request_uuid": "r1"}`)
+	api.respond(http.MethodGet, "/objects/isoimages/iso-1", `{"isoimage": {"object_uuid": "iso-1", "name": "debian", "status": "active"}}`)
+	api.setPower("server-1", true)
+
+	var checked []bool
+	installation, err := client.InstallServer(context.Background(), "server-1", InstallOptions{
+		ISOImage: ISOImageCreateRequest{Name: "debian", SourceURL: "http://example.com/debian.iso"},
+		Finished: func(ctx context.Context, server Server) (bool, error) {
+			checked = append(checked, server.Properties.Power)
+			return true, nil
+		},
+		DeleteISOImage: true,
+		Start:          true,
+	})
+	if err != nil {
+		t.Fatalf("InstallServer returned an error %v", err)
+	}
+	assert.Equal(t, Installation{ISOImageUUID: "iso-1", ISOImageCreated: true, StorageUUID: "storage-2"}, installation)
+	assert.Equal(t, []bool{true}, checked)
+	assert.Equal(t, []string{
+		"POST /objects/isoimages",
+		"PATCH /objects/servers/server-1/shutdown",
+		"POST /objects/servers/server-1/isoimages",
+		"PATCH /objects/servers/server-1/isoimages/iso-1",
+		"PATCH /objects/servers/server-1/power",
+		"PATCH /objects/servers/server-1/shutdown",
+		"DELETE /objects/servers/server-1/isoimages/iso-1",
+		"PATCH /objects/servers/server-1/storages/storage-2",
+		"DELETE /objects/isoimages/iso-1",
+		"PATCH /objects/servers/server-1/power",
+	}, api.recorded())
+	bodies := api.recordedBodies()
+	assert.JSONEq(t, `{"bootdevice": true, "name": "debian"}`, bodies[3])
+	assert.JSONEq(t, `{"bootdevice": true}`, bodies[7])
+}
+
+func TestClient_InstallServerTimeout(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, "/objects/servers/server-1", `{"server": {"object_uuid": "server-1",
+		"relations": {"storages": [{"object_uuid": "storage-1"}], "isoimages": [{"object_uuid": "iso-1"}]}}}`)
+	api.respond(http.MethodGet, "/objects/isoimages/iso-1", `{"isoimage": {"object_uuid": "iso-1", "status": "active"}}`)
+
+	//The server keeps running, so the installation is assumed finished after the timeout
+	opts := InstallOptions{ISOImageUUID: "iso-1", Timeout: 50 * time.Millisecond, Interval: 10 * time.Millisecond}
+	installation, err := client.InstallServer(context.Background(), "server-1", opts)
+	if err != nil {
+		t.Fatalf("InstallServer returned an error %v", err)
+	}
+	assert.Equal(t, Installation{ISOImageUUID: "iso-1", StorageUUID: "storage-1", TimedOut: true}, installation)
+	assert.Equal(t, []string{
+		"PATCH /objects/servers/server-1/isoimages/iso-1",
+		"PATCH /objects/servers/server-1/power",
+		"PATCH /objects/servers/server-1/shutdown",
+		"DELETE /objects/servers/server-1/isoimages/iso-1",
+		"PATCH /objects/servers/server-1/storages/storage-1",
+	}, api.recorded())
+
+	//With a callback, reaching the timeout is an error
+	opts.Finished = func(ctx context.Context, server Server) (bool, error) {
+		return false, nil
+	}
+	installation, err = client.InstallServer(context.Background(), "server-1", opts)
+	assert.Error(t, err)
+	assert.False(t, installation.TimedOut)
+}
+
+func TestClient_InstallServerISOImageNotReady(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, "/objects/servers/server-1", `{"server": {"object_uuid": "server-1",
+		"relations": {"storages": [{"object_uuid": "storage-1"}]}}}`)
+	api.respond(http.MethodGet, "/objects/isoimages/iso-1", `{"isoimage": {"object_uuid": "iso-1", "status": "downloading"}}`)
+
+	_, err := client.InstallServer(context.Background(), "server-1", InstallOptions{
+		ISOImageUUID:    "iso-1",
+		ISOImageTimeout: 50 * time.Millisecond,
+		Interval:        10 * time.Millisecond,
+	})
+	assert.Error(t, err)
+	assert.Empty(t, api.recorded())
+
+	api.respond(http.MethodGet, "/objects/servers/server-2", `{"server": {"object_uuid": "server-2"}}`)
+	_, err = client.InstallServer(context.Background(), "server-2", InstallOptions{ISOImageUUID: "iso-1"})
+	assert.Error(t, err)
+}
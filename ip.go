@@ -86,28 +86,14 @@ type IPUpdateRequest struct {
 	Labels     []string `json:"labels,omitempty"`
 }
 
-//IPEventList is JSON struct of a list of an IP's events
-type IPEventList struct {
-	List []IPEventProperties `json:"events"`
-}
+//IPEventList the list of events of an IP, it's the same type as EventList
+type IPEventList = EventList
 
-//IPEvent is JSON struct of a single IP
-type IPEvent struct {
-	Properties IPEventProperties `json:"event"`
-}
+//IPEvent a single event of an IP, it's the same type as Event
+type IPEvent = Event
 
-//IPEventProperties is JSON struct of an IP's properties
-type IPEventProperties struct {
-	ObjectType    string `json:"object_type"`
-	RequestUUID   string `json:"request_uuid"`
-	ObjectUUID    string `json:"object_uuid"`
-	Activity      string `json:"activity"`
-	RequestType   string `json:"request_type"`
-	RequestStatus string `json:"request_status"`
-	Change        string `json:"change"`
-	Timestamp     string `json:"timestamp"`
-	UserUUID      string `json:"user_uuid"`
-}
+//IPEventProperties the properties of an event of an IP, it's the same type as EventProperties
+type IPEventProperties = EventProperties
 
 //GetIP get a specific IP based on given id
 func (c *Client) GetIP(id string) (IP, error) {
@@ -136,9 +122,69 @@ func (c *Client) GetIPList() ([]IP, error) {
 		IPs = append(IPs, IP{Properties: properties})
 	}
 
+	sortList(IPs, SortByName, func(i int) listEntry {
+		return IPs[i].listEntry()
+	})
 	return IPs, err
 }
 
+//GetIPListWithOptions gets a list of available IPs matching the given options
+func (c *Client) GetIPListWithOptions(opts ListOptions) ([]IP, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	IPs, err := c.GetIPList()
+	var filtered []IP
+	for _, ip := range IPs {
+		if match(ip.listEntry()) {
+			filtered = append(filtered, ip)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetIPMap gets the IPs keyed by their UUID
+func (c *Client) GetIPMap() (map[string]IP, error) {
+	IPs, err := c.GetIPList()
+	ipMap := make(map[string]IP, len(IPs))
+	for _, ip := range IPs {
+		ipMap[ip.Properties.ObjectUUID] = ip
+	}
+	return ipMap, err
+}
+
+//GetIPByName gets an IP by its name. If no IP has the name an ObjectNotFoundError is returned,
+//if several have it an AmbiguousNameError.
+func (c *Client) GetIPByName(name string) (IP, error) {
+	IPs, err := c.GetIPList()
+	if err != nil {
+		return IP{}, err
+	}
+	i, err := findByName("IP", name, len(IPs), func(i int) listEntry {
+		return IPs[i].listEntry()
+	})
+	if err != nil {
+		return IP{}, err
+	}
+	return IPs[i], nil
+}
+
+//listEntry returns the attributes list options are applied to
+func (ip IP) listEntry() listEntry {
+	return listEntry{
+		uuid:         ip.Properties.ObjectUUID,
+		name:         ip.Properties.Name,
+		createTime:   ip.Properties.CreateTime,
+		locationUUID: ip.Properties.LocationUUID,
+		status:       ip.Properties.Status,
+		labels:       ip.Properties.Labels,
+	}
+}
+
 //CreateIP creates an IP
 func (c *Client) CreateIP(body IPCreateRequest) (IPCreateResponse, error) {
 	r := Request{
@@ -179,6 +225,34 @@ func (c *Client) UpdateIP(id string, body IPUpdateRequest) error {
 	return r.execute(*c, nil)
 }
 
+//AddIPLabels adds labels to an IP. Labels with a key the IP already has replace the existing ones
+func (c *Client) AddIPLabels(id string, labels ...string) error {
+	ip, err := c.GetIP(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiIPBase, id), ip.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdateIP(id, IPUpdateRequest{
+			Failover: ip.Properties.Failover,
+			Labels:   labels,
+		})
+	})
+}
+
+//RemoveIPLabels removes the labels with the given keys from an IP
+func (c *Client) RemoveIPLabels(id string, keys ...string) error {
+	ip, err := c.GetIP(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiIPBase, id), ip.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdateIP(id, IPUpdateRequest{
+			Failover: ip.Properties.Failover,
+			Labels:   labels,
+		})
+	})
+}
+
 //GetIPEventList gets a list of an IP's events
 func (c *Client) GetIPEventList(id string) ([]IPEvent, error) {
 	r := Request{
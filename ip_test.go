@@ -25,6 +25,66 @@ func TestClient_GetIPList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockIP()), fmt.Sprintf("%v", res))
 }
 
+func TestClient_GetIPListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiIPBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareIPListHTTPGet())
+	})
+	res, err := client.GetIPListWithOptions(ListOptions{NamePattern: "*", LabelSelector: "!deprecated"})
+	if err != nil {
+		t.Errorf("GetIPListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetIPListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetIPListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetIPListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetIPMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiIPBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareIPListHTTPGet())
+	})
+	res, err := client.GetIPMap()
+	if err != nil {
+		t.Errorf("GetIPMap returned an error %v", err)
+	}
+	mock := getMockIP()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
+func TestClient_GetIPByName(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiIPBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareIPListHTTPGet())
+	})
+	mock := getMockIP()
+	res, err := client.GetIPByName(mock.Properties.Name)
+	if err != nil {
+		t.Errorf("GetIPByName returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res))
+
+	_, err = client.GetIPByName("unknown")
+	assert.IsType(t, ObjectNotFoundError{}, err)
+}
+
 func TestClient_GetIP(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -86,6 +146,30 @@ func TestClient_UpdateIP(t *testing.T) {
 	}
 }
 
+func TestClient_AddIPLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiIPBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareIPHTTPGet())
+	err := client.AddIPLabels(dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddIPLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"label", "env=prod"}}, *updates)
+}
+
+func TestClient_RemoveIPLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiIPBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareIPHTTPGet())
+	err := client.RemoveIPLabels(dummyUUID, "label")
+	if err != nil {
+		t.Errorf("RemoveIPLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{}}, *updates)
+}
+
 func TestClient_DeleteIP(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -70,28 +70,14 @@ type ISOImageUpdateRequest struct {
 	Labels []string `json:"labels,omitempty"`
 }
 
-//ISOImageEventList is JSON struct of a list of an ISO-Image's events
-type ISOImageEventList struct {
-	List []ISOImageEventProperties `json:"events"`
-}
+//ISOImageEventList the list of events of an ISO-Image, it's the same type as EventList
+type ISOImageEventList = EventList
 
-//ISOImageEvent is JSON struct of a single event of an ISO-Image
-type ISOImageEvent struct {
-	Properties ISOImageEventProperties `json:"event"`
-}
+//ISOImageEvent a single event of an ISO-Image, it's the same type as Event
+type ISOImageEvent = Event
 
-//ISOImageEventProperties is JSON struct of an ISO-Image event
-type ISOImageEventProperties struct {
-	ObjectType    string `json:"object_type"`
-	RequestUUID   string `json:"request_uuid"`
-	ObjectUUID    string `json:"object_uuid"`
-	Activity      string `json:"activity"`
-	RequestType   string `json:"request_type"`
-	RequestStatus string `json:"request_status"`
-	Change        string `json:"change"`
-	Timestamp     string `json:"timestamp"`
-	UserUUID      string `json:"user_uuid"`
-}
+//ISOImageEventProperties the properties of an event of an ISO-Image, it's the same type as EventProperties
+type ISOImageEventProperties = EventProperties
 
 //GetISOImageList returns a list of available ISO images
 func (c *Client) GetISOImageList() ([]ISOImage, error) {
@@ -105,9 +91,69 @@ func (c *Client) GetISOImageList() ([]ISOImage, error) {
 	for _, properties := range response.List {
 		isoImages = append(isoImages, ISOImage{Properties: properties})
 	}
+	sortList(isoImages, SortByName, func(i int) listEntry {
+		return isoImages[i].listEntry()
+	})
 	return isoImages, err
 }
 
+//GetISOImageListWithOptions gets a list of available ISO images matching the given options
+func (c *Client) GetISOImageListWithOptions(opts ListOptions) ([]ISOImage, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	isoImages, err := c.GetISOImageList()
+	var filtered []ISOImage
+	for _, isoImage := range isoImages {
+		if match(isoImage.listEntry()) {
+			filtered = append(filtered, isoImage)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetISOImageMap gets the ISO-Images keyed by their UUID
+func (c *Client) GetISOImageMap() (map[string]ISOImage, error) {
+	isoImages, err := c.GetISOImageList()
+	isoImageMap := make(map[string]ISOImage, len(isoImages))
+	for _, isoImage := range isoImages {
+		isoImageMap[isoImage.Properties.ObjectUUID] = isoImage
+	}
+	return isoImageMap, err
+}
+
+//GetISOImageByName gets an ISO-Image by its name. If no ISO-Image has the name an ObjectNotFoundError is returned,
+//if several have it an AmbiguousNameError.
+func (c *Client) GetISOImageByName(name string) (ISOImage, error) {
+	isoImages, err := c.GetISOImageList()
+	if err != nil {
+		return ISOImage{}, err
+	}
+	i, err := findByName("ISO-Image", name, len(isoImages), func(i int) listEntry {
+		return isoImages[i].listEntry()
+	})
+	if err != nil {
+		return ISOImage{}, err
+	}
+	return isoImages[i], nil
+}
+
+//listEntry returns the attributes list options are applied to
+func (i ISOImage) listEntry() listEntry {
+	return listEntry{
+		uuid:         i.Properties.ObjectUUID,
+		name:         i.Properties.Name,
+		createTime:   i.Properties.CreateTime,
+		locationUUID: i.Properties.LocationUUID,
+		status:       i.Properties.Status,
+		labels:       i.Properties.Labels,
+	}
+}
+
 //GetISOImage returns a specific ISO image based on given id
 func (c *Client) GetISOImage(id string) (ISOImage, error) {
 	r := Request{
@@ -145,6 +191,28 @@ func (c *Client) UpdateISOImage(id string, body ISOImageUpdateRequest) error {
 	return r.execute(*c, nil)
 }
 
+//AddISOImageLabels adds labels to an ISO-Image. Labels with a key the ISO-Image already has replace the existing ones
+func (c *Client) AddISOImageLabels(id string, labels ...string) error {
+	isoImage, err := c.GetISOImage(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiISOBase, id), isoImage.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdateISOImage(id, ISOImageUpdateRequest{Labels: labels})
+	})
+}
+
+//RemoveISOImageLabels removes the labels with the given keys from an ISO-Image
+func (c *Client) RemoveISOImageLabels(id string, keys ...string) error {
+	isoImage, err := c.GetISOImage(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiISOBase, id), isoImage.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdateISOImage(id, ISOImageUpdateRequest{Labels: labels})
+	})
+}
+
 //DeleteISOImage deletes a specific ISO image
 func (c *Client) DeleteISOImage(id string) error {
 	r := Request{
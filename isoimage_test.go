@@ -25,6 +25,66 @@ func TestClient_GetISOImageList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockISOImage()), fmt.Sprintf("%v", res))
 }
 
+func TestClient_GetISOImageListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiISOBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareISOImageHTTPGetList())
+	})
+	res, err := client.GetISOImageListWithOptions(ListOptions{NamePattern: "*", LabelSelector: "!deprecated"})
+	if err != nil {
+		t.Errorf("GetISOImageListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetISOImageListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetISOImageListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetISOImageListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetISOImageMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiISOBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareISOImageHTTPGetList())
+	})
+	res, err := client.GetISOImageMap()
+	if err != nil {
+		t.Errorf("GetISOImageMap returned an error %v", err)
+	}
+	mock := getMockISOImage()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
+func TestClient_GetISOImageByName(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiISOBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareISOImageHTTPGetList())
+	})
+	mock := getMockISOImage()
+	res, err := client.GetISOImageByName(mock.Properties.Name)
+	if err != nil {
+		t.Errorf("GetISOImageByName returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res))
+
+	_, err = client.GetISOImageByName("unknown")
+	assert.IsType(t, ObjectNotFoundError{}, err)
+}
+
 func TestClient_GetISOImage(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -84,6 +144,30 @@ func TestClient_UpdateISOImage(t *testing.T) {
 	}
 }
 
+func TestClient_AddISOImageLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiISOBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareISOImageHTTPGet())
+	err := client.AddISOImageLabels(dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddISOImageLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"label", "env=prod"}}, *updates)
+}
+
+func TestClient_RemoveISOImageLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiISOBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareISOImageHTTPGet())
+	err := client.RemoveISOImageLabels(dummyUUID, "label")
+	if err != nil {
+		t.Errorf("RemoveISOImageLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{}}, *updates)
+}
+
 func TestClient_DeleteISOImage(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
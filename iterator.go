@@ -0,0 +1,302 @@
+package gsclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+)
+
+//listIterator streams the elements of a list response instead of reading the whole response into memory.
+//A list response is a JSON object, the list is the value of its key listKey and either an object keyed by UUID
+//or an array. The request is sent on the first call of next.
+//
+//The API doesn't paginate lists, a list is always returned by a single response.
+type listIterator struct {
+	client  *Client
+	uri     string
+	listKey string
+	body    io.ReadCloser
+	decoder *json.Decoder
+	keyed   bool
+	done    bool
+	err     error
+}
+
+//next decodes the next element of the list into v, it returns false at the end of the list or on an error
+func (it *listIterator) next(v interface{}) bool {
+	if it.done {
+		return false
+	}
+	if it.decoder == nil {
+		if it.err = it.open(); it.err != nil || it.done {
+			it.close()
+			return false
+		}
+	}
+	if !it.decoder.More() {
+		it.close()
+		return false
+	}
+	if it.keyed {
+		//Skip the UUID, it's part of the element as well
+		if _, it.err = it.decoder.Token(); it.err != nil {
+			it.close()
+			return false
+		}
+	}
+	if it.err = it.decoder.Decode(v); it.err != nil {
+		it.close()
+		return false
+	}
+	return true
+}
+
+//open sends the request and reads the response up to the first element of the list
+func (it *listIterator) open() error {
+	c := it.client
+	url := c.cfg.APIUrl + it.uri
+	c.cfg.logger.Debugf("%v request sent to URL: %v", http.MethodGet, url)
+	r := Request{
+		uri:    it.uri,
+		method: http.MethodGet,
+	}
+	statusCode, body, err := r.openAuthorized(*c, url, nil)
+	if err != nil {
+		return err
+	}
+	it.body = body
+	c.cfg.logger.Debugf("Status code returned: %v", statusCode)
+	if statusCode >= 300 {
+		var errorMessage RequestError
+		errorMessage.StatusCode = statusCode
+		iostream, _ := ioutil.ReadAll(body)
+		json.Unmarshal(iostream, &errorMessage)
+		c.cfg.logger.Errorf("Error message: %v. Status: %v. Code: %v.", errorMessage.ErrorMessage, errorMessage.StatusMessage, errorMessage.StatusCode)
+		return errorMessage
+	}
+
+	it.decoder = json.NewDecoder(body)
+	if err := it.expectDelim('{'); err != nil {
+		return err
+	}
+	for it.decoder.More() {
+		token, err := it.decoder.Token()
+		if err != nil {
+			return err
+		}
+		if token != it.listKey {
+			var skipped json.RawMessage
+			if err := it.decoder.Decode(&skipped); err != nil {
+				return err
+			}
+			continue
+		}
+		token, err = it.decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch token {
+		case json.Delim('{'):
+			it.keyed = true
+			return nil
+		case json.Delim('['):
+			return nil
+		case nil:
+			it.done = true
+			return nil
+		}
+		return fmt.Errorf("unexpected value %v of key %q in list response", token, it.listKey)
+	}
+	//The response doesn't contain the list at all
+	it.done = true
+	return nil
+}
+
+//expectDelim reads a delimiter from the response
+func (it *listIterator) expectDelim(delim json.Delim) error {
+	token, err := it.decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("unexpected token %v in list response, expected %v", token, delim)
+	}
+	return nil
+}
+
+//close ends the iteration and releases the connection
+func (it *listIterator) close() error {
+	it.done = true
+	if it.body == nil {
+		return nil
+	}
+	err := it.body.Close()
+	it.body = nil
+	return err
+}
+
+//ServerIterator iterates over the servers of the account without holding the whole list in memory.
+//Servers are returned in the order of the API's response.
+type ServerIterator struct {
+	it      listIterator
+	current Server
+}
+
+//GetServerIterator returns an iterator over the available servers
+func (c *Client) GetServerIterator() *ServerIterator {
+	return &ServerIterator{it: listIterator{client: c, uri: apiServerBase, listKey: "servers"}}
+}
+
+//Next advances to the next server. It returns false when there are no more servers or an error has occurred.
+func (i *ServerIterator) Next() bool {
+	i.current = Server{}
+	return i.it.next(&i.current.Properties)
+}
+
+//Server returns the current server
+func (i *ServerIterator) Server() Server {
+	return i.current
+}
+
+//Err returns the error which has ended the iteration, if any
+func (i *ServerIterator) Err() error {
+	return i.it.err
+}
+
+//Close ends the iteration early and releases the connection
+func (i *ServerIterator) Close() error {
+	return i.it.close()
+}
+
+//StorageIterator iterates over the storages of the account without holding the whole list in memory.
+//Storages are returned in the order of the API's response.
+type StorageIterator struct {
+	it      listIterator
+	current Storage
+}
+
+//GetStorageIterator returns an iterator over the available storages
+func (c *Client) GetStorageIterator() *StorageIterator {
+	return &StorageIterator{it: listIterator{client: c, uri: apiStorageBase, listKey: "storages"}}
+}
+
+//Next advances to the next storage. It returns false when there are no more storages or an error has occurred.
+func (i *StorageIterator) Next() bool {
+	i.current = Storage{}
+	return i.it.next(&i.current.Properties)
+}
+
+//Storage returns the current storage
+func (i *StorageIterator) Storage() Storage {
+	return i.current
+}
+
+//Err returns the error which has ended the iteration, if any
+func (i *StorageIterator) Err() error {
+	return i.it.err
+}
+
+//Close ends the iteration early and releases the connection
+func (i *StorageIterator) Close() error {
+	return i.it.close()
+}
+
+//StorageSnapshotIterator iterates over the snapshots of a storage without holding the whole list in memory.
+//Snapshots are returned in the order of the API's response.
+type StorageSnapshotIterator struct {
+	it      listIterator
+	current StorageSnapshot
+}
+
+//GetStorageSnapshotIterator returns an iterator over the snapshots of a storage
+func (c *Client) GetStorageSnapshotIterator(id string) *StorageSnapshotIterator {
+	return &StorageSnapshotIterator{it: listIterator{client: c, uri: path.Join(apiStorageBase, id, "snapshots"), listKey: "snapshots"}}
+}
+
+//Next advances to the next snapshot. It returns false when there are no more snapshots or an error has occurred.
+func (i *StorageSnapshotIterator) Next() bool {
+	i.current = StorageSnapshot{}
+	return i.it.next(&i.current.Properties)
+}
+
+//StorageSnapshot returns the current snapshot
+func (i *StorageSnapshotIterator) StorageSnapshot() StorageSnapshot {
+	return i.current
+}
+
+//Err returns the error which has ended the iteration, if any
+func (i *StorageSnapshotIterator) Err() error {
+	return i.it.err
+}
+
+//Close ends the iteration early and releases the connection
+func (i *StorageSnapshotIterator) Close() error {
+	return i.it.close()
+}
+
+//ServerEventIterator iterates over the events of a server without holding the whole list in memory
+type ServerEventIterator struct {
+	it      listIterator
+	current ServerEvent
+}
+
+//GetServerEventIterator returns an iterator over the events of a server
+func (c *Client) GetServerEventIterator(id string) *ServerEventIterator {
+	return &ServerEventIterator{it: listIterator{client: c, uri: path.Join(apiServerBase, id, "events"), listKey: "events"}}
+}
+
+//Next advances to the next event. It returns false when there are no more events or an error has occurred.
+func (i *ServerEventIterator) Next() bool {
+	i.current = ServerEvent{}
+	return i.it.next(&i.current.Properties)
+}
+
+//ServerEvent returns the current event
+func (i *ServerEventIterator) ServerEvent() ServerEvent {
+	return i.current
+}
+
+//Err returns the error which has ended the iteration, if any
+func (i *ServerEventIterator) Err() error {
+	return i.it.err
+}
+
+//Close ends the iteration early and releases the connection
+func (i *ServerEventIterator) Close() error {
+	return i.it.close()
+}
+
+//StorageEventIterator iterates over the events of a storage without holding the whole list in memory
+type StorageEventIterator struct {
+	it      listIterator
+	current StorageEvent
+}
+
+//GetStorageEventIterator returns an iterator over the events of a storage
+func (c *Client) GetStorageEventIterator(id string) *StorageEventIterator {
+	return &StorageEventIterator{it: listIterator{client: c, uri: path.Join(apiStorageBase, id, "events"), listKey: "events"}}
+}
+
+//Next advances to the next event. It returns false when there are no more events or an error has occurred.
+func (i *StorageEventIterator) Next() bool {
+	i.current = StorageEvent{}
+	return i.it.next(&i.current.Properties)
+}
+
+//StorageEvent returns the current event
+func (i *StorageEventIterator) StorageEvent() StorageEvent {
+	return i.current
+}
+
+//Err returns the error which has ended the iteration, if any
+func (i *StorageEventIterator) Err() error {
+	return i.it.err
+}
+
+//Close ends the iteration early and releases the connection
+func (i *StorageEventIterator) Close() error {
+	return i.it.close()
+}
@@ -0,0 +1,145 @@
+package gsclient
+
+import (
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetServerIterator(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiServerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, `{"servers": {
+			"a": {"object_uuid": "a", "name": "web"},
+			"b": {"object_uuid": "b", "name": "db"},
+			"c": {"object_uuid": "c", "name": "mail"}
+		}}`)
+	})
+	it := client.GetServerIterator()
+	var names []string
+	for it.Next() {
+		names = append(names, it.Server().Properties.Name)
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"web", "db", "mail"}, names)
+	assert.False(t, it.Next())
+
+	//Early termination
+	it = client.GetServerIterator()
+	assert.True(t, it.Next())
+	assert.Equal(t, "a", it.Server().Properties.ObjectUUID)
+	assert.Nil(t, it.Close())
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Err())
+}
+
+func TestClient_GetStorageIterator(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiStorageBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		writer.Header().Set("Content-Encoding", "gzip")
+		gzipWriter := gzip.NewWriter(writer)
+		defer gzipWriter.Close()
+		fmt.Fprint(gzipWriter, prepareStorageListHTTPGet())
+	})
+	it := client.GetStorageIterator()
+	var storages []Storage
+	for it.Next() {
+		storages = append(storages, it.Storage())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, fmt.Sprintf("[%v]", getMockStorage()), fmt.Sprintf("%v", storages))
+}
+
+func TestClient_GetStorageSnapshotIterator(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID, "snapshots")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareStorageSnapshotListHTTPGet())
+	})
+	it := client.GetStorageSnapshotIterator(dummyUUID)
+	var snapshots []StorageSnapshot
+	for it.Next() {
+		snapshots = append(snapshots, it.StorageSnapshot())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, fmt.Sprintf("[%v]", getMockStorageSnapshot()), fmt.Sprintf("%v", snapshots))
+}
+
+func TestClient_GetServerEventIterator(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiServerBase, dummyUUID, "events")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareServerEventListHTTPGet())
+	})
+	it := client.GetServerEventIterator(dummyUUID)
+	var events []ServerEvent
+	for it.Next() {
+		events = append(events, it.ServerEvent())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, fmt.Sprintf("[%v]", getMockServerEvent()), fmt.Sprintf("%v", events))
+}
+
+func TestClient_GetStorageEventIterator(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID, "events")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareStorageEventListHTTPGet())
+	})
+	it := client.GetStorageEventIterator(dummyUUID)
+	var events []StorageEvent
+	for it.Next() {
+		events = append(events, it.StorageEvent())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, fmt.Sprintf("[%v]", getMockStorageEvent()), fmt.Sprintf("%v", events))
+}
+
+func TestListIterator_Errors(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	mux.HandleFunc(apiServerBase, func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(writer, `{"status": "Forbidden", "message": "no access"}`)
+	})
+	mux.HandleFunc(apiStorageBase, func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"storages": {"a": {"object_uuid": "a"}, "b": `)
+	})
+	mux.HandleFunc(path.Join(apiServerBase, dummyUUID, "events"), func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"other": [1, 2]}`)
+	})
+
+	serverIterator := client.GetServerIterator()
+	assert.False(t, serverIterator.Next())
+	if requestError, ok := serverIterator.Err().(RequestError); assert.True(t, ok) {
+		assert.Equal(t, http.StatusForbidden, requestError.StatusCode)
+		assert.Equal(t, "no access", requestError.ErrorMessage)
+	}
+
+	//A truncated response ends the iteration with an error
+	storageIterator := client.GetStorageIterator()
+	assert.True(t, storageIterator.Next())
+	assert.False(t, storageIterator.Next())
+	assert.Error(t, storageIterator.Err())
+
+	//A response without the list is an empty list
+	eventIterator := client.GetServerEventIterator(dummyUUID)
+	assert.False(t, eventIterator.Next())
+	assert.Nil(t, eventIterator.Err())
+}
@@ -0,0 +1,159 @@
+package gsclient
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+//Labels structured view of an object's labels.
+//A label "key=value" is stored as key with its value, a label without "=" as key with an empty value.
+type Labels map[string]string
+
+//LabelConflictPolicy decides what happens when a list of labels contains a key more than once with different values
+type LabelConflictPolicy int
+
+const (
+	//LabelConflictError fails parsing on duplicate keys
+	LabelConflictError LabelConflictPolicy = iota
+	//LabelConflictFirstWins keeps the value of the first label with the key
+	LabelConflictFirstWins
+	//LabelConflictLastWins keeps the value of the last label with the key
+	LabelConflictLastWins
+)
+
+//splitLabel splits a label into its key and value
+func splitLabel(label string) (string, string) {
+	if idx := strings.Index(label, "="); idx >= 0 {
+		return label[:idx], label[idx+1:]
+	}
+	return label, ""
+}
+
+//ParseLabels parses a list of labels into a map. Labels occurring more than once with the same value are not
+//a conflict, different values for the same key are handled according to the policy.
+func ParseLabels(labels []string, policy LabelConflictPolicy) (Labels, error) {
+	parsed := make(Labels, len(labels))
+	for _, label := range labels {
+		key, value := splitLabel(label)
+		if key == "" {
+			return nil, fmt.Errorf("invalid label %q: missing key", label)
+		}
+		existing, ok := parsed[key]
+		if ok && existing != value {
+			switch policy {
+			case LabelConflictFirstWins:
+				continue
+			case LabelConflictLastWins:
+			default:
+				return nil, fmt.Errorf("conflicting labels for key %q: %q and %q", key, existing, value)
+			}
+		}
+		parsed[key] = value
+	}
+	return parsed, nil
+}
+
+//Get returns the value of a label and whether the label exists
+func (l Labels) Get(key string) (string, bool) {
+	value, ok := l[key]
+	return value, ok
+}
+
+//Has checks whether a label exists
+func (l Labels) Has(key string) bool {
+	_, ok := l[key]
+	return ok
+}
+
+//Set sets the value of a label, an empty value results in a label without "="
+func (l Labels) Set(key, value string) {
+	l[key] = value
+}
+
+//Delete removes a label
+func (l Labels) Delete(key string) {
+	delete(l, key)
+}
+
+//Strings returns the labels as they are sent to the API, sorted by key
+func (l Labels) Strings() []string {
+	labels := make([]string, 0, len(l))
+	for key, value := range l {
+		labels = append(labels, joinLabel(key, value))
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+func joinLabel(key, value string) string {
+	if value == "" {
+		return key
+	}
+	return key + "=" + value
+}
+
+//labelsUpdateRequest JSON struct of a request only updating labels
+type labelsUpdateRequest struct {
+	Labels []string `json:"labels"`
+}
+
+//modifiedLabels adds and removes labels while keeping the order of the existing ones.
+//Added labels replace existing labels with the same key. Returns whether anything has changed.
+func modifiedLabels(current, add, removeKeys []string) ([]string, bool, error) {
+	added, err := ParseLabels(add, LabelConflictError)
+	if err != nil {
+		return nil, false, err
+	}
+	removed := make(map[string]bool, len(removeKeys))
+	for _, key := range removeKeys {
+		removed[key] = true
+	}
+	labels := []string{}
+	seen := make(map[string]bool, len(current))
+	changed := false
+	for _, label := range current {
+		key, _ := splitLabel(label)
+		if removed[key] || seen[key] {
+			changed = true
+			continue
+		}
+		if value, ok := added[key]; ok {
+			seen[key] = true
+			if newLabel := joinLabel(key, value); newLabel != label {
+				label = newLabel
+				changed = true
+			}
+		}
+		labels = append(labels, label)
+	}
+	for _, label := range add {
+		key, value := splitLabel(label)
+		if !seen[key] {
+			seen[key] = true
+			labels = append(labels, joinLabel(key, value))
+			changed = true
+		}
+	}
+	return labels, changed, nil
+}
+
+//modifyLabels does the modify-write part of a read-modify-write of an object's labels.
+//update is only called if the labels have changed. The update requests omit empty label lists,
+//so removing the last label is sent to the object's uri directly.
+func (c *Client) modifyLabels(uri string, current, add, removeKeys []string, update func(labels []string) error) error {
+	labels, changed, err := modifiedLabels(current, add, removeKeys)
+	if err != nil || !changed {
+		return err
+	}
+	if len(labels) == 0 {
+		r := Request{
+			uri:    uri,
+			method: http.MethodPatch,
+			body:   labelsUpdateRequest{Labels: labels},
+		}
+		return r.execute(*c, nil)
+	}
+	return update(labels)
+}
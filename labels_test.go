@@ -0,0 +1,105 @@
+package gsclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//handleLabelsUpdate serves an object on GET and records the labels sent by PATCH requests
+func handleLabelsUpdate(t *testing.T, mux *http.ServeMux, uri, object string) *[][]string {
+	var updates [][]string
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		switch request.Method {
+		case http.MethodGet:
+			fmt.Fprint(writer, object)
+		case http.MethodPatch:
+			var body struct {
+				Labels *[]string `json:"labels"`
+			}
+			if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+				t.Errorf("decoding request body failed with error %v", err)
+			}
+			if assert.NotNil(t, body.Labels, "labels missing in update request") {
+				updates = append(updates, *body.Labels)
+			}
+			fmt.Fprint(writer, "")
+		default:
+			t.Errorf("unexpected %v request", request.Method)
+		}
+	})
+	return &updates
+}
+
+func TestParseLabels(t *testing.T) {
+	labels, err := ParseLabels([]string{"team=payments", "env=prod", "backup", "env=prod", "ttl=2h=x"}, LabelConflictError)
+	if err != nil {
+		t.Fatalf("ParseLabels returned an error %v", err)
+	}
+	assert.Equal(t, Labels{"team": "payments", "env": "prod", "backup": "", "ttl": "2h=x"}, labels)
+
+	duplicates := []string{"env=prod", "env=dev"}
+	_, err = ParseLabels(duplicates, LabelConflictError)
+	assert.Error(t, err)
+	labels, err = ParseLabels(duplicates, LabelConflictFirstWins)
+	assert.Nil(t, err)
+	assert.Equal(t, Labels{"env": "prod"}, labels)
+	labels, err = ParseLabels(duplicates, LabelConflictLastWins)
+	assert.Nil(t, err)
+	assert.Equal(t, Labels{"env": "dev"}, labels)
+
+	_, err = ParseLabels([]string{"=prod"}, LabelConflictLastWins)
+	assert.Error(t, err)
+}
+
+func TestLabels_Methods(t *testing.T) {
+	labels := Labels{}
+	labels.Set("env", "prod")
+	labels.Set("backup", "")
+	labels.Set("team", "payments")
+
+	value, ok := labels.Get("env")
+	assert.True(t, ok)
+	assert.Equal(t, "prod", value)
+	_, ok = labels.Get("ttl")
+	assert.False(t, ok)
+	assert.True(t, labels.Has("backup"))
+	assert.False(t, labels.Has("ttl"))
+
+	labels.Delete("team")
+	assert.False(t, labels.Has("team"))
+	assert.Equal(t, []string{"backup", "env=prod"}, labels.Strings())
+}
+
+func TestModifiedLabels(t *testing.T) {
+	testCases := []struct {
+		current    []string
+		add        []string
+		removeKeys []string
+		expected   []string
+		changed    bool
+	}{
+		{[]string{"env=prod", "backup"}, []string{"team=payments"}, nil, []string{"env=prod", "backup", "team=payments"}, true},
+		{[]string{"env=prod", "backup"}, []string{"env=dev"}, nil, []string{"env=dev", "backup"}, true},
+		{[]string{"env=prod", "backup"}, []string{"env=prod"}, nil, []string{"env=prod", "backup"}, false},
+		{[]string{"env=prod", "backup"}, nil, []string{"env"}, []string{"backup"}, true},
+		{[]string{"env=prod", "backup"}, nil, []string{"ttl"}, []string{"env=prod", "backup"}, false},
+		{[]string{"env=prod", "env=dev"}, []string{"env=test"}, nil, []string{"env=test"}, true},
+		{[]string{"backup"}, nil, []string{"backup"}, []string{}, true},
+		{nil, []string{"backup"}, nil, []string{"backup"}, true},
+	}
+	for _, test := range testCases {
+		labels, changed, err := modifiedLabels(test.current, test.add, test.removeKeys)
+		if err != nil {
+			t.Fatalf("modifiedLabels returned an error %v", err)
+		}
+		assert.Equal(t, test.expected, labels)
+		assert.Equal(t, test.changed, changed)
+	}
+
+	_, _, err := modifiedLabels(nil, []string{"env=prod", "env=dev"}, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,200 @@
+package gsclient
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+//ListOptions options for filtering a list of objects.
+//The object lists of the API can't be filtered by query parameters, so the options are applied to the list
+//returned by the API. Objects must match all options that are set.
+type ListOptions struct {
+	//LabelSelector is a comma separated list of label requirements, see ParseLabelSelector
+	LabelSelector string
+	//NamePattern is a shell pattern the name must match, see path.Match for the syntax. As with paths "*" doesn't
+	//match "/", so the pattern for location names like "de/fra" is "*/*".
+	NamePattern string
+	//LocationUUID is the UUID of the location an object must be in. Objects without a location never match.
+	LocationUUID string
+	//Status is the status an object must have, e.g. "active"
+	Status string
+	//SortBy is the key the list is sorted by, defaults to the name
+	SortBy SortKey
+}
+
+//SortKey key a list of objects is sorted by. Objects with equal keys are sorted by their UUID.
+type SortKey int
+
+const (
+	//SortByName sorts by name, the default for all lists
+	SortByName SortKey = iota
+	//SortByCreateTime sorts by create time, oldest first. Objects without a create time come first.
+	SortByCreateTime
+	//SortByUUID sorts by UUID
+	SortByUUID
+)
+
+//labelOperator kind of a label requirement
+type labelOperator int
+
+const (
+	labelExists labelOperator = iota
+	labelNotExists
+	labelEquals
+	labelNotEquals
+)
+
+//LabelRequirement a single requirement of a label selector
+type LabelRequirement struct {
+	Key      string
+	Value    string
+	operator labelOperator
+}
+
+//LabelSelector a list of label requirements, all of them must be met
+type LabelSelector []LabelRequirement
+
+//ParseLabelSelector parses a comma separated list of label requirements:
+//
+//  key        a label "key" or a label "key=<any value>" exists
+//  !key       neither a label "key" nor a label "key=<any value>" exists
+//  key=value  the label "key=value" exists
+//  key!=value the label "key=value" doesn't exist
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	var requirements LabelSelector
+	for _, part := range strings.Split(selector, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		var requirement LabelRequirement
+		switch {
+		case strings.Contains(part, "!="):
+			idx := strings.Index(part, "!=")
+			requirement = LabelRequirement{Key: part[:idx], Value: part[idx+2:], operator: labelNotEquals}
+		case strings.Contains(part, "="):
+			idx := strings.Index(part, "=")
+			requirement = LabelRequirement{Key: part[:idx], Value: part[idx+1:], operator: labelEquals}
+		case strings.HasPrefix(part, "!"):
+			requirement = LabelRequirement{Key: part[1:], operator: labelNotExists}
+		default:
+			requirement = LabelRequirement{Key: part, operator: labelExists}
+		}
+		requirement.Key = strings.TrimSpace(requirement.Key)
+		requirement.Value = strings.TrimSpace(requirement.Value)
+		if requirement.Key == "" || strings.ContainsAny(requirement.Key, "!=") {
+			return nil, fmt.Errorf("invalid label requirement %q in selector %q", part, selector)
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements, nil
+}
+
+//Matches checks whether a list of labels meets all requirements
+func (s LabelSelector) Matches(labels []string) bool {
+	for _, requirement := range s {
+		if !requirement.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+//Matches checks whether a list of labels meets the requirement
+func (r LabelRequirement) Matches(labels []string) bool {
+	switch r.operator {
+	case labelExists:
+		return hasLabelKey(labels, r.Key)
+	case labelNotExists:
+		return !hasLabelKey(labels, r.Key)
+	case labelEquals:
+		return hasLabel(labels, r.Key+"="+r.Value)
+	case labelNotEquals:
+		return !hasLabel(labels, r.Key+"="+r.Value)
+	}
+	return false
+}
+
+//hasLabelKey checks whether there is a label "key" or "key=<any value>"
+func hasLabelKey(labels []string, key string) bool {
+	for _, label := range labels {
+		if label == key || strings.HasPrefix(label, key+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+func hasLabel(labels []string, wanted string) bool {
+	for _, label := range labels {
+		if label == wanted {
+			return true
+		}
+	}
+	return false
+}
+
+//listEntry the attributes of an object the list options are applied to
+type listEntry struct {
+	uuid         string
+	name         string
+	createTime   string
+	locationUUID string
+	status       string
+	labels       []string
+}
+
+//matcher validates the options and returns a function checking whether an object matches them
+func (o ListOptions) matcher() (func(entry listEntry) bool, error) {
+	selector, err := ParseLabelSelector(o.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	if o.SortBy < SortByName || o.SortBy > SortByUUID {
+		return nil, fmt.Errorf("invalid sort key %d", o.SortBy)
+	}
+	if o.NamePattern != "" {
+		if _, err := path.Match(o.NamePattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid name pattern %q: %v", o.NamePattern, err)
+		}
+	}
+	return func(entry listEntry) bool {
+		if o.NamePattern != "" {
+			if ok, _ := path.Match(o.NamePattern, entry.name); !ok {
+				return false
+			}
+		}
+		if o.LocationUUID != "" && entry.locationUUID != o.LocationUUID {
+			return false
+		}
+		if o.Status != "" && entry.status != o.Status {
+			return false
+		}
+		return selector.Matches(entry.labels)
+	}, nil
+}
+
+//less compares two objects by the given key, objects with equal keys are compared by UUID.
+//The create times are compared as returned by the API, which always uses the same format and time zone.
+func (e listEntry) less(other listEntry, key SortKey) bool {
+	var a, b string
+	switch key {
+	case SortByName:
+		a, b = e.name, other.name
+	case SortByCreateTime:
+		a, b = e.createTime, other.createTime
+	}
+	if a != b {
+		return a < b
+	}
+	return e.uuid < other.uuid
+}
+
+//sortList sorts a slice of objects, entry returns the attributes of the i-th object
+func sortList(list interface{}, key SortKey, entry func(i int) listEntry) {
+	sort.Slice(list, func(i, j int) bool {
+		return entry(i).less(entry(j), key)
+	})
+}
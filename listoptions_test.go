@@ -0,0 +1,115 @@
+package gsclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	selector, err := ParseLabelSelector(" env=prod, !deprecated,team!=ops ,backup")
+	if err != nil {
+		t.Fatalf("ParseLabelSelector returned an error %v", err)
+	}
+	assert.Equal(t, LabelSelector{
+		{Key: "env", Value: "prod", operator: labelEquals},
+		{Key: "deprecated", operator: labelNotExists},
+		{Key: "team", Value: "ops", operator: labelNotEquals},
+		{Key: "backup", operator: labelExists},
+	}, selector)
+
+	selector, err = ParseLabelSelector("")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(selector))
+
+	for _, invalid := range []string{"=prod", "!", "!=ops", "!env=prod"} {
+		_, err = ParseLabelSelector(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestLabelSelector_Matches(t *testing.T) {
+	labels := []string{"env=prod", "backup", "team=web"}
+	testCases := []struct {
+		selector string
+		matches  bool
+	}{
+		{"", true},
+		{"env", true},
+		{"backup", true},
+		{"env=prod", true},
+		{"env=dev", false},
+		{"env!=dev", true},
+		{"env!=prod", false},
+		{"!deprecated", true},
+		{"!backup", false},
+		{"!team", false},
+		{"env=prod,team=web", true},
+		{"env=prod,team=ops", false},
+	}
+	for _, test := range testCases {
+		selector, err := ParseLabelSelector(test.selector)
+		if err != nil {
+			t.Fatalf("ParseLabelSelector returned an error %v", err)
+		}
+		assert.Equal(t, test.matches, selector.Matches(labels), test.selector)
+	}
+}
+
+func TestListOptions_matcher(t *testing.T) {
+	entry := listEntry{name: "web-01", locationUUID: dummyUUID, status: "active", labels: []string{"env=prod"}}
+	testCases := []struct {
+		opts    ListOptions
+		matches bool
+	}{
+		{ListOptions{}, true},
+		{ListOptions{NamePattern: "web-*"}, true},
+		{ListOptions{NamePattern: "db-*"}, false},
+		{ListOptions{LocationUUID: dummyUUID}, true},
+		{ListOptions{LocationUUID: "other"}, false},
+		{ListOptions{Status: "active"}, true},
+		{ListOptions{Status: "deleted"}, false},
+		{ListOptions{NamePattern: "web-??", Status: "active", LabelSelector: "env=prod"}, true},
+		{ListOptions{NamePattern: "web-??", Status: "active", LabelSelector: "env=dev"}, false},
+	}
+	for _, test := range testCases {
+		match, err := test.opts.matcher()
+		if err != nil {
+			t.Fatalf("matcher returned an error %v", err)
+		}
+		assert.Equal(t, test.matches, match(entry), "%+v", test.opts)
+	}
+
+	_, err := ListOptions{NamePattern: "["}.matcher()
+	assert.Error(t, err)
+	_, err = ListOptions{LabelSelector: "env=prod,=dev"}.matcher()
+	assert.Error(t, err)
+}
+
+func TestSortList(t *testing.T) {
+	servers := []Server{
+		{Properties: ServerProperties{ObjectUUID: "c", Name: "web", CreateTime: "2019-01-01T10:00:00Z"}},
+		{Properties: ServerProperties{ObjectUUID: "a", Name: "web", CreateTime: "2019-03-01T10:00:00Z"}},
+		{Properties: ServerProperties{ObjectUUID: "b", Name: "db", CreateTime: "2019-02-01T10:00:00Z"}},
+	}
+	uuids := func() []string {
+		var res []string
+		for _, server := range servers {
+			res = append(res, server.Properties.ObjectUUID)
+		}
+		return res
+	}
+	entry := func(i int) listEntry {
+		return servers[i].listEntry()
+	}
+
+	sortList(servers, SortByName, entry)
+	assert.Equal(t, []string{"b", "a", "c"}, uuids())
+	sortList(servers, SortByCreateTime, entry)
+	assert.Equal(t, []string{"c", "b", "a"}, uuids())
+	sortList(servers, SortByUUID, entry)
+	assert.Equal(t, []string{"a", "b", "c"}, uuids())
+
+	_, err := ListOptions{SortBy: SortKey(42)}.matcher()
+	assert.Error(t, err)
+}
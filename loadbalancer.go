@@ -86,28 +86,14 @@ type LoadBalancerCreateResponse struct {
 	ObjectUUID  string `json:"object_uuid"`
 }
 
-//LoadBalancerEventList is the JSON struct for a loadbalancer's events
-type LoadBalancerEventList struct {
-	List []LoadBalancerEventProperties `json:"events"`
-}
+//LoadBalancerEventList the list of events of a load balancer, it's the same type as EventList
+type LoadBalancerEventList = EventList
 
-//LoadBalancerEvent is JSON struct for a load balancer
-type LoadBalancerEvent struct {
-	Properties LoadBalancerEventProperties `json:"event"`
-}
+//LoadBalancerEvent a single event of a load balancer, it's the same type as Event
+type LoadBalancerEvent = Event
 
-//LoadBalancerEventProperties is the properties of a loadbalancer's event
-type LoadBalancerEventProperties struct {
-	ObjectUUID    string `json:"object_uuid"`
-	ObjectType    string `json:"object_type"`
-	RequestUUID   string `json:"request_uuid"`
-	RequestType   string `json:"request_type"`
-	Activity      string `json:"activity"`
-	RequestStatus string `json:"request_status"`
-	Change        string `json:"change"`
-	Timestamp     string `json:"timestamp"`
-	UserUUID      string `json:"user_uuid"`
-}
+//LoadBalancerEventProperties the properties of an event of a load balancer, it's the same type as EventProperties
+type LoadBalancerEventProperties = EventProperties
 
 //GetLoadBalancerList returns a list of loadbalancers
 func (c *Client) GetLoadBalancerList() ([]LoadBalancer, error) {
@@ -121,9 +107,69 @@ func (c *Client) GetLoadBalancerList() ([]LoadBalancer, error) {
 	for _, properties := range response.List {
 		loadBalancers = append(loadBalancers, LoadBalancer{Properties: properties})
 	}
+	sortList(loadBalancers, SortByName, func(i int) listEntry {
+		return loadBalancers[i].listEntry()
+	})
 	return loadBalancers, err
 }
 
+//GetLoadBalancerListWithOptions gets a list of available loadbalancers matching the given options
+func (c *Client) GetLoadBalancerListWithOptions(opts ListOptions) ([]LoadBalancer, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	loadBalancers, err := c.GetLoadBalancerList()
+	var filtered []LoadBalancer
+	for _, loadBalancer := range loadBalancers {
+		if match(loadBalancer.listEntry()) {
+			filtered = append(filtered, loadBalancer)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetLoadBalancerMap gets the loadbalancers keyed by their UUID
+func (c *Client) GetLoadBalancerMap() (map[string]LoadBalancer, error) {
+	loadBalancers, err := c.GetLoadBalancerList()
+	loadBalancerMap := make(map[string]LoadBalancer, len(loadBalancers))
+	for _, loadBalancer := range loadBalancers {
+		loadBalancerMap[loadBalancer.Properties.ObjectUUID] = loadBalancer
+	}
+	return loadBalancerMap, err
+}
+
+//GetLoadBalancerByName gets a loadbalancer by its name. If no loadbalancer has the name an ObjectNotFoundError is returned,
+//if several have it an AmbiguousNameError.
+func (c *Client) GetLoadBalancerByName(name string) (LoadBalancer, error) {
+	loadBalancers, err := c.GetLoadBalancerList()
+	if err != nil {
+		return LoadBalancer{}, err
+	}
+	i, err := findByName("loadbalancer", name, len(loadBalancers), func(i int) listEntry {
+		return loadBalancers[i].listEntry()
+	})
+	if err != nil {
+		return LoadBalancer{}, err
+	}
+	return loadBalancers[i], nil
+}
+
+//listEntry returns the attributes list options are applied to
+func (lb LoadBalancer) listEntry() listEntry {
+	return listEntry{
+		uuid:         lb.Properties.ObjectUUID,
+		name:         lb.Properties.Name,
+		createTime:   lb.Properties.CreateTime,
+		locationUUID: lb.Properties.LocationUUID,
+		status:       lb.Properties.Status,
+		labels:       lb.Properties.Labels,
+	}
+}
+
 //GetLoadBalancer returns a loadbalancer of a given uuid
 func (c *Client) GetLoadBalancer(id string) (LoadBalancer, error) {
 	r := Request{
@@ -161,6 +207,48 @@ func (c *Client) UpdateLoadBalancer(id string, body LoadBalancerUpdateRequest) e
 	return r.execute(*c, nil)
 }
 
+//AddLoadBalancerLabels adds labels to a loadbalancer. Labels with a key the loadbalancer already has replace the existing ones
+func (c *Client) AddLoadBalancerLabels(id string, labels ...string) error {
+	loadBalancer, err := c.GetLoadBalancer(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiLoadBalancerBase, id), loadBalancer.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdateLoadBalancer(id, LoadBalancerUpdateRequest{
+			Name:                loadBalancer.Properties.Name,
+			ListenIPv6UUID:      loadBalancer.Properties.ListenIPv6UUID,
+			ListenIPv4UUID:      loadBalancer.Properties.ListenIPv4UUID,
+			Algorithm:           loadBalancer.Properties.Algorithm,
+			ForwardingRules:     loadBalancer.Properties.ForwardingRules,
+			BackendServers:      loadBalancer.Properties.BackendServers,
+			Labels:              labels,
+			LocationUUID:        loadBalancer.Properties.LocationUUID,
+			RedirectHTTPToHTTPS: loadBalancer.Properties.RedirectHTTPToHTTPS,
+		})
+	})
+}
+
+//RemoveLoadBalancerLabels removes the labels with the given keys from a loadbalancer
+func (c *Client) RemoveLoadBalancerLabels(id string, keys ...string) error {
+	loadBalancer, err := c.GetLoadBalancer(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiLoadBalancerBase, id), loadBalancer.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdateLoadBalancer(id, LoadBalancerUpdateRequest{
+			Name:                loadBalancer.Properties.Name,
+			ListenIPv6UUID:      loadBalancer.Properties.ListenIPv6UUID,
+			ListenIPv4UUID:      loadBalancer.Properties.ListenIPv4UUID,
+			Algorithm:           loadBalancer.Properties.Algorithm,
+			ForwardingRules:     loadBalancer.Properties.ForwardingRules,
+			BackendServers:      loadBalancer.Properties.BackendServers,
+			Labels:              labels,
+			LocationUUID:        loadBalancer.Properties.LocationUUID,
+			RedirectHTTPToHTTPS: loadBalancer.Properties.RedirectHTTPToHTTPS,
+		})
+	})
+}
+
 //GetLoadBalancerEventList retrieves events of a given uuid
 func (c *Client) GetLoadBalancerEventList(id string) ([]LoadBalancerEvent, error) {
 	r := Request{
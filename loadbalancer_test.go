@@ -75,6 +75,66 @@ func TestClient_GetLoadBalancerList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", expectedObjects), fmt.Sprintf("%v", loadbalancers))
 }
 
+func TestClient_GetLoadBalancerListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiLoadBalancerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareLoadBalancerHTTPListResponse())
+	})
+	res, err := client.GetLoadBalancerListWithOptions(ListOptions{NamePattern: "*", LabelSelector: "!deprecated"})
+	if err != nil {
+		t.Errorf("GetLoadBalancerListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetLoadBalancerListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetLoadBalancerListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetLoadBalancerListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetLoadBalancerMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiLoadBalancerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareLoadBalancerHTTPListResponse())
+	})
+	res, err := client.GetLoadBalancerMap()
+	if err != nil {
+		t.Errorf("GetLoadBalancerMap returned an error %v", err)
+	}
+	mock := getMockLoadbalancer()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
+func TestClient_GetLoadBalancerByName(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiLoadBalancerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareLoadBalancerHTTPListResponse())
+	})
+	mock := getMockLoadbalancer()
+	res, err := client.GetLoadBalancerByName(mock.Properties.Name)
+	if err != nil {
+		t.Errorf("GetLoadBalancerByName returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res))
+
+	_, err = client.GetLoadBalancerByName("unknown")
+	assert.IsType(t, ObjectNotFoundError{}, err)
+}
+
 func TestClient_UpdateLoadBalancer(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -95,6 +155,30 @@ func TestClient_UpdateLoadBalancer(t *testing.T) {
 	}
 }
 
+func TestClient_AddLoadBalancerLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiLoadBalancerBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareLoadBalancerHTTPGetResponse())
+	err := client.AddLoadBalancerLabels(dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddLoadBalancerLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"nice", "env=prod"}}, *updates)
+}
+
+func TestClient_RemoveLoadBalancerLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiLoadBalancerBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareLoadBalancerHTTPGetResponse())
+	err := client.RemoveLoadBalancerLabels(dummyUUID, "nice")
+	if err != nil {
+		t.Errorf("RemoveLoadBalancerLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{}}, *updates)
+}
+
 func TestClient_DeleteLoadBalancer(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
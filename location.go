@@ -37,9 +37,67 @@ func (c *Client) GetLocationList() ([]Location, error) {
 	for _, properties := range response.List {
 		locations = append(locations, Location{Properties: properties})
 	}
+	sortList(locations, SortByName, func(i int) listEntry {
+		return locations[i].listEntry()
+	})
 	return locations, err
 }
 
+//GetLocationListWithOptions gets a list of locations matching the given options
+func (c *Client) GetLocationListWithOptions(opts ListOptions) ([]Location, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	locations, err := c.GetLocationList()
+	var filtered []Location
+	for _, location := range locations {
+		if match(location.listEntry()) {
+			filtered = append(filtered, location)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetLocationMap gets the locations keyed by their UUID
+func (c *Client) GetLocationMap() (map[string]Location, error) {
+	locations, err := c.GetLocationList()
+	locationMap := make(map[string]Location, len(locations))
+	for _, location := range locations {
+		locationMap[location.Properties.ObjectUUID] = location
+	}
+	return locationMap, err
+}
+
+//GetLocationByName gets a location by its name. If no location has the name an ObjectNotFoundError is returned,
+//if several have it an AmbiguousNameError.
+func (c *Client) GetLocationByName(name string) (Location, error) {
+	locations, err := c.GetLocationList()
+	if err != nil {
+		return Location{}, err
+	}
+	i, err := findByName("location", name, len(locations), func(i int) listEntry {
+		return locations[i].listEntry()
+	})
+	if err != nil {
+		return Location{}, err
+	}
+	return locations[i], nil
+}
+
+//listEntry returns the attributes list options are applied to
+func (l Location) listEntry() listEntry {
+	return listEntry{
+		uuid:   l.Properties.ObjectUUID,
+		name:   l.Properties.Name,
+		status: l.Properties.Status,
+		labels: l.Properties.Labels,
+	}
+}
+
 //GetLocation gets a specific location
 func (c *Client) GetLocation(id string) (Location, error) {
 	r := Request{
@@ -25,6 +25,66 @@ func TestClient_GetLocationList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockLocation()), fmt.Sprintf("%v", res))
 }
 
+func TestClient_GetLocationListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiLocationBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareLocationListHTTPGet())
+	})
+	res, err := client.GetLocationListWithOptions(ListOptions{NamePattern: "de/*", LabelSelector: "!deprecated", SortBy: SortByCreateTime})
+	if err != nil {
+		t.Errorf("GetLocationListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetLocationListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetLocationListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetLocationListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetLocationMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiLocationBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareLocationListHTTPGet())
+	})
+	res, err := client.GetLocationMap()
+	if err != nil {
+		t.Errorf("GetLocationMap returned an error %v", err)
+	}
+	mock := getMockLocation()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
+func TestClient_GetLocationByName(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiLocationBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareLocationListHTTPGet())
+	})
+	mock := getMockLocation()
+	res, err := client.GetLocationByName(mock.Properties.Name)
+	if err != nil {
+		t.Errorf("GetLocationByName returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res))
+
+	_, err = client.GetLocationByName("unknown")
+	assert.IsType(t, ObjectNotFoundError{}, err)
+}
+
 func TestClient_GetLocation(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -0,0 +1,50 @@
+package gsclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+//ObjectNotFoundError is returned when no object of a kind has the name looked up
+type ObjectNotFoundError struct {
+	Kind string
+	Name string
+}
+
+//Error returns the error as string
+func (e ObjectNotFoundError) Error() string {
+	return fmt.Sprintf("%s %q not found", e.Kind, e.Name)
+}
+
+//AmbiguousNameError is returned when more than one object of a kind has the name looked up
+type AmbiguousNameError struct {
+	Kind  string
+	Name  string
+	UUIDs []string
+}
+
+//Error returns the error as string
+func (e AmbiguousNameError) Error() string {
+	return fmt.Sprintf("%s name %q is ambiguous, it matches %s", e.Kind, e.Name, strings.Join(e.UUIDs, ", "))
+}
+
+//findByName returns the index of the only object with the given name, entry returns the attributes of the i-th object
+func findByName(kind, name string, n int, entry func(i int) listEntry) (int, error) {
+	found := -1
+	var uuids []string
+	for i := 0; i < n; i++ {
+		e := entry(i)
+		if e.name != name {
+			continue
+		}
+		found = i
+		uuids = append(uuids, e.uuid)
+	}
+	switch len(uuids) {
+	case 0:
+		return -1, ObjectNotFoundError{Kind: kind, Name: name}
+	case 1:
+		return found, nil
+	}
+	return -1, AmbiguousNameError{Kind: kind, Name: name, UUIDs: uuids}
+}
@@ -0,0 +1,49 @@
+package gsclient
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindByName(t *testing.T) {
+	entries := []listEntry{
+		{uuid: "a", name: "web"},
+		{uuid: "b", name: "db"},
+		{uuid: "c", name: "web"},
+	}
+	entry := func(i int) listEntry {
+		return entries[i]
+	}
+
+	i, err := findByName("server", "db", len(entries), entry)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, i)
+
+	_, err = findByName("server", "mail", len(entries), entry)
+	assert.Equal(t, ObjectNotFoundError{Kind: "server", Name: "mail"}, err)
+	assert.Equal(t, `server "mail" not found`, err.Error())
+
+	_, err = findByName("server", "web", len(entries), entry)
+	assert.Equal(t, AmbiguousNameError{Kind: "server", Name: "web", UUIDs: []string{"a", "c"}}, err)
+	assert.Equal(t, `server name "web" is ambiguous, it matches a, c`, err.Error())
+}
+
+func TestClient_GetServerByNameAmbiguous(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiServerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, `{"servers": {
+			"b": {"object_uuid": "b", "name": "web"},
+			"a": {"object_uuid": "a", "name": "web"}
+		}}`)
+	})
+	_, err := client.GetServerByName("web")
+	if ambiguousNameError, ok := err.(AmbiguousNameError); assert.True(t, ok) {
+		assert.Equal(t, []string{"a", "b"}, ambiguousNameError.UUIDs)
+	}
+}
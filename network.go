@@ -76,32 +76,19 @@ type NetworkCreateResponse struct {
 
 //NetworkUpdateRequest is JSON of a request for updating a network
 type NetworkUpdateRequest struct {
-	Name       string `json:"name,omitempty"`
-	L2Security bool   `json:"l2security"`
+	Name       string   `json:"name,omitempty"`
+	L2Security bool     `json:"l2security"`
+	Labels     []string `json:"labels,omitempty"`
 }
 
-//NetworkEventList is JSON struct of a list of a network's events
-type NetworkEventList struct {
-	List []NetworkEventProperties `json:"events"`
-}
+//NetworkEventList the list of events of a network, it's the same type as EventList
+type NetworkEventList = EventList
 
-//NetworkEvent is JSON struct of a single event of a network
-type NetworkEvent struct {
-	Properties NetworkEventProperties `json:"event"`
-}
+//NetworkEvent a single event of a network, it's the same type as Event
+type NetworkEvent = Event
 
-//NetworkEventProperties is JSON struct of properties of an event
-type NetworkEventProperties struct {
-	ObjectType    string `json:"object_type"`
-	RequestUUID   string `json:"request_uuid"`
-	ObjectUUID    string `json:"object_uuid"`
-	Activity      string `json:"activity"`
-	RequestType   string `json:"request_type"`
-	RequestStatus string `json:"request_status"`
-	Change        string `json:"change"`
-	Timestamp     string `json:"timestamp"`
-	UserUUID      string `json:"user_uuid"`
-}
+//NetworkEventProperties the properties of an event of a network, it's the same type as EventProperties
+type NetworkEventProperties = EventProperties
 
 //GetNetwork get a specific network based on given id
 func (c *Client) GetNetwork(id string) (Network, error) {
@@ -150,6 +137,34 @@ func (c *Client) UpdateNetwork(id string, body NetworkUpdateRequest) error {
 	return r.execute(*c, nil)
 }
 
+//AddNetworkLabels adds labels to a network. Labels with a key the network already has replace the existing ones
+func (c *Client) AddNetworkLabels(id string, labels ...string) error {
+	network, err := c.GetNetwork(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiNetworkBase, id), network.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdateNetwork(id, NetworkUpdateRequest{
+			L2Security: network.Properties.L2Security,
+			Labels:     labels,
+		})
+	})
+}
+
+//RemoveNetworkLabels removes the labels with the given keys from a network
+func (c *Client) RemoveNetworkLabels(id string, keys ...string) error {
+	network, err := c.GetNetwork(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiNetworkBase, id), network.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdateNetwork(id, NetworkUpdateRequest{
+			L2Security: network.Properties.L2Security,
+			Labels:     labels,
+		})
+	})
+}
+
 //GetNetworkList gets a list of available networks
 func (c *Client) GetNetworkList() ([]Network, error) {
 	r := Request{
@@ -164,9 +179,69 @@ func (c *Client) GetNetworkList() ([]Network, error) {
 			Properties: properties,
 		})
 	}
+	sortList(networks, SortByName, func(i int) listEntry {
+		return networks[i].listEntry()
+	})
 	return networks, err
 }
 
+//GetNetworkListWithOptions gets a list of available networks matching the given options
+func (c *Client) GetNetworkListWithOptions(opts ListOptions) ([]Network, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	networks, err := c.GetNetworkList()
+	var filtered []Network
+	for _, network := range networks {
+		if match(network.listEntry()) {
+			filtered = append(filtered, network)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetNetworkMap gets the networks keyed by their UUID
+func (c *Client) GetNetworkMap() (map[string]Network, error) {
+	networks, err := c.GetNetworkList()
+	networkMap := make(map[string]Network, len(networks))
+	for _, network := range networks {
+		networkMap[network.Properties.ObjectUUID] = network
+	}
+	return networkMap, err
+}
+
+//GetNetworkByName gets a network by its name. If no network has the name an ObjectNotFoundError is returned,
+//if several have it an AmbiguousNameError.
+func (c *Client) GetNetworkByName(name string) (Network, error) {
+	networks, err := c.GetNetworkList()
+	if err != nil {
+		return Network{}, err
+	}
+	i, err := findByName("network", name, len(networks), func(i int) listEntry {
+		return networks[i].listEntry()
+	})
+	if err != nil {
+		return Network{}, err
+	}
+	return networks[i], nil
+}
+
+//listEntry returns the attributes list options are applied to
+func (n Network) listEntry() listEntry {
+	return listEntry{
+		uuid:         n.Properties.ObjectUUID,
+		name:         n.Properties.Name,
+		createTime:   n.Properties.CreateTime,
+		locationUUID: n.Properties.LocationUUID,
+		status:       n.Properties.Status,
+		labels:       n.Properties.Labels,
+	}
+}
+
 //GetNetworkEventList gets a list of a network's events
 func (c *Client) GetNetworkEventList(id string) ([]NetworkEvent, error) {
 	r := Request{
@@ -25,6 +25,66 @@ func TestClient_GetNetworkList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockNetwork()), fmt.Sprintf("%v", res))
 }
 
+func TestClient_GetNetworkListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiNetworkBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareNetworkListHTTPGet())
+	})
+	res, err := client.GetNetworkListWithOptions(ListOptions{NamePattern: "*", LabelSelector: "!deprecated"})
+	if err != nil {
+		t.Errorf("GetNetworkListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetNetworkListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetNetworkListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetNetworkListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetNetworkMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiNetworkBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareNetworkListHTTPGet())
+	})
+	res, err := client.GetNetworkMap()
+	if err != nil {
+		t.Errorf("GetNetworkMap returned an error %v", err)
+	}
+	mock := getMockNetwork()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
+func TestClient_GetNetworkByName(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiNetworkBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareNetworkListHTTPGet())
+	})
+	mock := getMockNetwork()
+	res, err := client.GetNetworkByName(mock.Properties.Name)
+	if err != nil {
+		t.Errorf("GetNetworkByName returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res))
+
+	_, err = client.GetNetworkByName("unknown")
+	assert.IsType(t, ObjectNotFoundError{}, err)
+}
+
 func TestClient_GetNetwork(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -84,6 +144,31 @@ func TestClient_UpdateNetwork(t *testing.T) {
 	}
 }
 
+func TestClient_AddNetworkLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiNetworkBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareNetworkHTTPGet())
+	err := client.AddNetworkLabels(dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddNetworkLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"env=prod"}}, *updates)
+}
+
+func TestClient_RemoveNetworkLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiNetworkBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareNetworkHTTPGet())
+	err := client.RemoveNetworkLabels(dummyUUID, "label")
+	if err != nil {
+		t.Errorf("RemoveNetworkLabels returned an error %v", err)
+	}
+	//The network has no labels, so nothing is updated
+	assert.Equal(t, 0, len(*updates))
+}
+
 func TestClient_DeleteNetwork(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
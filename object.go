@@ -0,0 +1,733 @@
+package gsclient
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+)
+
+//ObjectKind kind of an object, e.g. "server"
+type ObjectKind string
+
+//Kinds of objects supported by the generic object functions
+const (
+	KindServer           ObjectKind = "server"
+	KindStorage          ObjectKind = "storage"
+	KindNetwork          ObjectKind = "network"
+	KindIP               ObjectKind = "ip"
+	KindSshkey           ObjectKind = "sshkey"
+	KindTemplate         ObjectKind = "template"
+	KindLoadBalancer     ObjectKind = "loadbalancer"
+	KindISOImage         ObjectKind = "isoimage"
+	KindFirewall         ObjectKind = "firewall"
+	KindPaaSService      ObjectKind = "paas_service"
+	KindPaaSSecurityZone ObjectKind = "paas_security_zone"
+	KindLocation         ObjectKind = "location"
+)
+
+//Object attributes all kinds of objects have in common. Server, Storage, Network and the other object types
+//implement it, so tools can work on objects of any kind. Attributes an object doesn't have are empty.
+type Object interface {
+	Kind() ObjectKind
+	UUID() string
+	Name() string
+	Labels() []string
+	Status() string
+	LocationUUID() string
+	CurrentPrice() float64
+}
+
+//objectKind the functions handling the objects of a kind, delete is nil if objects of the kind can't be deleted
+type objectKind struct {
+	uri    string
+	get    func(c *Client, id string) (Object, error)
+	list   func(c *Client) ([]Object, error)
+	delete func(c *Client, id string) error
+	events bool
+}
+
+//objectKinds is the registry of all kinds of objects
+var objectKinds = map[ObjectKind]objectKind{
+	KindServer: {
+		uri: apiServerBase,
+		get: func(c *Client, id string) (Object, error) {
+			return c.GetServer(id)
+		},
+		list: func(c *Client) ([]Object, error) {
+			list, err := c.GetServerList()
+			objects := make([]Object, len(list))
+			for i := range list {
+				objects[i] = list[i]
+			}
+			return objects, err
+		},
+		delete: (*Client).DeleteServer,
+		events: true,
+	},
+	KindStorage: {
+		uri: apiStorageBase,
+		get: func(c *Client, id string) (Object, error) {
+			return c.GetStorage(id)
+		},
+		list: func(c *Client) ([]Object, error) {
+			list, err := c.GetStorageList()
+			objects := make([]Object, len(list))
+			for i := range list {
+				objects[i] = list[i]
+			}
+			return objects, err
+		},
+		delete: (*Client).DeleteStorage,
+		events: true,
+	},
+	KindNetwork: {
+		uri: apiNetworkBase,
+		get: func(c *Client, id string) (Object, error) {
+			return c.GetNetwork(id)
+		},
+		list: func(c *Client) ([]Object, error) {
+			list, err := c.GetNetworkList()
+			objects := make([]Object, len(list))
+			for i := range list {
+				objects[i] = list[i]
+			}
+			return objects, err
+		},
+		delete: (*Client).DeleteNetwork,
+		events: true,
+	},
+	KindIP: {
+		uri: apiIPBase,
+		get: func(c *Client, id string) (Object, error) {
+			return c.GetIP(id)
+		},
+		list: func(c *Client) ([]Object, error) {
+			list, err := c.GetIPList()
+			objects := make([]Object, len(list))
+			for i := range list {
+				objects[i] = list[i]
+			}
+			return objects, err
+		},
+		delete: (*Client).DeleteIP,
+		events: true,
+	},
+	KindSshkey: {
+		uri: apiSshkeyBase,
+		get: func(c *Client, id string) (Object, error) {
+			return c.GetSshkey(id)
+		},
+		list: func(c *Client) ([]Object, error) {
+			list, err := c.GetSshkeyList()
+			objects := make([]Object, len(list))
+			for i := range list {
+				objects[i] = list[i]
+			}
+			return objects, err
+		},
+		delete: (*Client).DeleteSshkey,
+		events: true,
+	},
+	KindTemplate: {
+		uri: apiTemplateBase,
+		get: func(c *Client, id string) (Object, error) {
+			return c.GetTemplate(id)
+		},
+		list: func(c *Client) ([]Object, error) {
+			list, err := c.GetTemplateList()
+			objects := make([]Object, len(list))
+			for i := range list {
+				objects[i] = list[i]
+			}
+			return objects, err
+		},
+		delete: (*Client).DeleteTemplate,
+		events: true,
+	},
+	KindLoadBalancer: {
+		uri: apiLoadBalancerBase,
+		get: func(c *Client, id string) (Object, error) {
+			return c.GetLoadBalancer(id)
+		},
+		list: func(c *Client) ([]Object, error) {
+			list, err := c.GetLoadBalancerList()
+			objects := make([]Object, len(list))
+			for i := range list {
+				objects[i] = list[i]
+			}
+			return objects, err
+		},
+		delete: (*Client).DeleteLoadBalancer,
+		events: true,
+	},
+	KindISOImage: {
+		uri: apiISOBase,
+		get: func(c *Client, id string) (Object, error) {
+			return c.GetISOImage(id)
+		},
+		list: func(c *Client) ([]Object, error) {
+			list, err := c.GetISOImageList()
+			objects := make([]Object, len(list))
+			for i := range list {
+				objects[i] = list[i]
+			}
+			return objects, err
+		},
+		delete: (*Client).DeleteISOImage,
+		events: true,
+	},
+	KindFirewall: {
+		uri: apiFirewallBase,
+		get: func(c *Client, id string) (Object, error) {
+			return c.GetFirewall(id)
+		},
+		list: func(c *Client) ([]Object, error) {
+			list, err := c.GetFirewallList()
+			objects := make([]Object, len(list))
+			for i := range list {
+				objects[i] = list[i]
+			}
+			return objects, err
+		},
+		delete: (*Client).DeleteFirewall,
+		events: true,
+	},
+	KindPaaSService: {
+		uri: path.Join(apiPaaSBase, "services"),
+		get: func(c *Client, id string) (Object, error) {
+			return c.GetPaaSService(id)
+		},
+		list: func(c *Client) ([]Object, error) {
+			list, err := c.GetPaaSServiceList()
+			objects := make([]Object, len(list))
+			for i := range list {
+				objects[i] = list[i]
+			}
+			return objects, err
+		},
+		delete: (*Client).DeletePaaSService,
+	},
+	KindPaaSSecurityZone: {
+		uri: path.Join(apiPaaSBase, "security_zones"),
+		get: func(c *Client, id string) (Object, error) {
+			return c.GetPaaSSecurityZone(id)
+		},
+		list: func(c *Client) ([]Object, error) {
+			list, err := c.GetPaaSSecurityZoneList()
+			objects := make([]Object, len(list))
+			for i := range list {
+				objects[i] = list[i]
+			}
+			return objects, err
+		},
+		delete: (*Client).DeletePaaSSecurityZone,
+	},
+	KindLocation: {
+		uri: apiLocationBase,
+		get: func(c *Client, id string) (Object, error) {
+			return c.GetLocation(id)
+		},
+		list: func(c *Client) ([]Object, error) {
+			list, err := c.GetLocationList()
+			objects := make([]Object, len(list))
+			for i := range list {
+				objects[i] = list[i]
+			}
+			return objects, err
+		},
+	},
+}
+
+//ObjectKinds returns all kinds of objects supported by the generic object functions, sorted by name
+func ObjectKinds() []ObjectKind {
+	kinds := make([]ObjectKind, 0, len(objectKinds))
+	for kind := range objectKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i] < kinds[j]
+	})
+	return kinds
+}
+
+//lookupObjectKind returns the registry entry of a kind
+func lookupObjectKind(kind ObjectKind) (objectKind, error) {
+	entry, ok := objectKinds[kind]
+	if !ok {
+		return objectKind{}, fmt.Errorf("unknown object kind %q", kind)
+	}
+	return entry, nil
+}
+
+//GetObject gets an object of any kind
+func (c *Client) GetObject(kind ObjectKind, id string) (Object, error) {
+	entry, err := lookupObjectKind(kind)
+	if err != nil {
+		return nil, err
+	}
+	return entry.get(c, id)
+}
+
+//GetObjectList gets a list of all objects of a kind, sorted by name like the typed lists
+func (c *Client) GetObjectList(kind ObjectKind) ([]Object, error) {
+	entry, err := lookupObjectKind(kind)
+	if err != nil {
+		return nil, err
+	}
+	return entry.list(c)
+}
+
+//DeleteObject deletes an object of any kind. Servers are deleted as they are, they aren't shut down first.
+func (c *Client) DeleteObject(kind ObjectKind, id string) error {
+	entry, err := lookupObjectKind(kind)
+	if err != nil {
+		return err
+	}
+	if entry.delete == nil {
+		return fmt.Errorf("objects of kind %q cannot be deleted", kind)
+	}
+	return entry.delete(c, id)
+}
+
+//GetObjectEventList gets a list of the events of an object of any kind
+func (c *Client) GetObjectEventList(kind ObjectKind, id string) ([]Event, error) {
+	entry, err := lookupObjectKind(kind)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.events {
+		return nil, fmt.Errorf("objects of kind %q have no events", kind)
+	}
+	r := Request{
+		uri:    path.Join(entry.uri, id, "events"),
+		method: http.MethodGet,
+	}
+	var response EventList
+	var events []Event
+	err = r.execute(*c, &response)
+	for _, properties := range response.List {
+		events = append(events, Event{Properties: properties})
+	}
+	return events, err
+}
+
+//Kind returns KindServer
+func (s Server) Kind() ObjectKind {
+	return KindServer
+}
+
+//UUID returns the UUID of the object
+func (s Server) UUID() string {
+	return s.Properties.ObjectUUID
+}
+
+//Name returns the name of the object
+func (s Server) Name() string {
+	return s.Properties.Name
+}
+
+//Labels returns the labels of the object
+func (s Server) Labels() []string {
+	return s.Properties.Labels
+}
+
+//Status returns the status of the object
+func (s Server) Status() string {
+	return s.Properties.Status
+}
+
+//LocationUUID returns the UUID of the object's location
+func (s Server) LocationUUID() string {
+	return s.Properties.LocationUUID
+}
+
+//CurrentPrice returns the current price of the object
+func (s Server) CurrentPrice() float64 {
+	return s.Properties.CurrentPrice
+}
+
+//Kind returns KindStorage
+func (s Storage) Kind() ObjectKind {
+	return KindStorage
+}
+
+//UUID returns the UUID of the object
+func (s Storage) UUID() string {
+	return s.Properties.ObjectUUID
+}
+
+//Name returns the name of the object
+func (s Storage) Name() string {
+	return s.Properties.Name
+}
+
+//Labels returns the labels of the object
+func (s Storage) Labels() []string {
+	return s.Properties.Labels
+}
+
+//Status returns the status of the object
+func (s Storage) Status() string {
+	return s.Properties.Status
+}
+
+//LocationUUID returns the UUID of the object's location
+func (s Storage) LocationUUID() string {
+	return s.Properties.LocationUUID
+}
+
+//CurrentPrice returns the current price of the object
+func (s Storage) CurrentPrice() float64 {
+	return s.Properties.CurrentPrice
+}
+
+//Kind returns KindNetwork
+func (n Network) Kind() ObjectKind {
+	return KindNetwork
+}
+
+//UUID returns the UUID of the object
+func (n Network) UUID() string {
+	return n.Properties.ObjectUUID
+}
+
+//Name returns the name of the object
+func (n Network) Name() string {
+	return n.Properties.Name
+}
+
+//Labels returns the labels of the object
+func (n Network) Labels() []string {
+	return n.Properties.Labels
+}
+
+//Status returns the status of the object
+func (n Network) Status() string {
+	return n.Properties.Status
+}
+
+//LocationUUID returns the UUID of the object's location
+func (n Network) LocationUUID() string {
+	return n.Properties.LocationUUID
+}
+
+//CurrentPrice returns the current price of the object, which is always 0
+func (n Network) CurrentPrice() float64 {
+	return 0
+}
+
+//Kind returns KindIP
+func (ip IP) Kind() ObjectKind {
+	return KindIP
+}
+
+//UUID returns the UUID of the object
+func (ip IP) UUID() string {
+	return ip.Properties.ObjectUUID
+}
+
+//Name returns the name of the object
+func (ip IP) Name() string {
+	return ip.Properties.Name
+}
+
+//Labels returns the labels of the object
+func (ip IP) Labels() []string {
+	return ip.Properties.Labels
+}
+
+//Status returns the status of the object
+func (ip IP) Status() string {
+	return ip.Properties.Status
+}
+
+//LocationUUID returns the UUID of the object's location
+func (ip IP) LocationUUID() string {
+	return ip.Properties.LocationUUID
+}
+
+//CurrentPrice returns the current price of the object
+func (ip IP) CurrentPrice() float64 {
+	return ip.Properties.CurrentPrice
+}
+
+//Kind returns KindSshkey
+func (s Sshkey) Kind() ObjectKind {
+	return KindSshkey
+}
+
+//UUID returns the UUID of the object
+func (s Sshkey) UUID() string {
+	return s.Properties.ObjectUUID
+}
+
+//Name returns the name of the object
+func (s Sshkey) Name() string {
+	return s.Properties.Name
+}
+
+//Labels returns the labels of the object
+func (s Sshkey) Labels() []string {
+	return s.Properties.Labels
+}
+
+//Status returns the status of the object
+func (s Sshkey) Status() string {
+	return s.Properties.Status
+}
+
+//LocationUUID returns the UUID of the object's location, which is always empty
+func (s Sshkey) LocationUUID() string {
+	return ""
+}
+
+//CurrentPrice returns the current price of the object, which is always 0
+func (s Sshkey) CurrentPrice() float64 {
+	return 0
+}
+
+//Kind returns KindTemplate
+func (t Template) Kind() ObjectKind {
+	return KindTemplate
+}
+
+//UUID returns the UUID of the object
+func (t Template) UUID() string {
+	return t.Properties.ObjectUUID
+}
+
+//Name returns the name of the object
+func (t Template) Name() string {
+	return t.Properties.Name
+}
+
+//Labels returns the labels of the object
+func (t Template) Labels() []string {
+	return t.Properties.Labels
+}
+
+//Status returns the status of the object
+func (t Template) Status() string {
+	return t.Properties.Status
+}
+
+//LocationUUID returns the UUID of the object's location
+func (t Template) LocationUUID() string {
+	return t.Properties.LocationUUID
+}
+
+//CurrentPrice returns the current price of the object
+func (t Template) CurrentPrice() float64 {
+	return t.Properties.CurrentPrice
+}
+
+//Kind returns KindLoadBalancer
+func (lb LoadBalancer) Kind() ObjectKind {
+	return KindLoadBalancer
+}
+
+//UUID returns the UUID of the object
+func (lb LoadBalancer) UUID() string {
+	return lb.Properties.ObjectUUID
+}
+
+//Name returns the name of the object
+func (lb LoadBalancer) Name() string {
+	return lb.Properties.Name
+}
+
+//Labels returns the labels of the object
+func (lb LoadBalancer) Labels() []string {
+	return lb.Properties.Labels
+}
+
+//Status returns the status of the object
+func (lb LoadBalancer) Status() string {
+	return lb.Properties.Status
+}
+
+//LocationUUID returns the UUID of the object's location
+func (lb LoadBalancer) LocationUUID() string {
+	return lb.Properties.LocationUUID
+}
+
+//CurrentPrice returns the current price of the object
+func (lb LoadBalancer) CurrentPrice() float64 {
+	return lb.Properties.CurrentPrice
+}
+
+//Kind returns KindISOImage
+func (i ISOImage) Kind() ObjectKind {
+	return KindISOImage
+}
+
+//UUID returns the UUID of the object
+func (i ISOImage) UUID() string {
+	return i.Properties.ObjectUUID
+}
+
+//Name returns the name of the object
+func (i ISOImage) Name() string {
+	return i.Properties.Name
+}
+
+//Labels returns the labels of the object
+func (i ISOImage) Labels() []string {
+	return i.Properties.Labels
+}
+
+//Status returns the status of the object
+func (i ISOImage) Status() string {
+	return i.Properties.Status
+}
+
+//LocationUUID returns the UUID of the object's location
+func (i ISOImage) LocationUUID() string {
+	return i.Properties.LocationUUID
+}
+
+//CurrentPrice returns the current price of the object
+func (i ISOImage) CurrentPrice() float64 {
+	return i.Properties.CurrentPrice
+}
+
+//Kind returns KindFirewall
+func (f Firewall) Kind() ObjectKind {
+	return KindFirewall
+}
+
+//UUID returns the UUID of the object
+func (f Firewall) UUID() string {
+	return f.Properties.ObjectUUID
+}
+
+//Name returns the name of the object
+func (f Firewall) Name() string {
+	return f.Properties.Name
+}
+
+//Labels returns the labels of the object
+func (f Firewall) Labels() []string {
+	return f.Properties.Labels
+}
+
+//Status returns the status of the object
+func (f Firewall) Status() string {
+	return f.Properties.Status
+}
+
+//LocationUUID returns the UUID of the object's location, which is always empty
+func (f Firewall) LocationUUID() string {
+	return ""
+}
+
+//CurrentPrice returns the current price of the object, which is always 0
+func (f Firewall) CurrentPrice() float64 {
+	return 0
+}
+
+//Kind returns KindPaaSService
+func (p PaaSService) Kind() ObjectKind {
+	return KindPaaSService
+}
+
+//UUID returns the UUID of the object
+func (p PaaSService) UUID() string {
+	return p.Properties.ObjectUUID
+}
+
+//Name returns the name of the object
+func (p PaaSService) Name() string {
+	return p.Properties.Name
+}
+
+//Labels returns the labels of the object
+func (p PaaSService) Labels() []string {
+	return p.Properties.Labels
+}
+
+//Status returns the status of the object
+func (p PaaSService) Status() string {
+	return p.Properties.Status
+}
+
+//LocationUUID returns the UUID of the object's location, which is always empty
+func (p PaaSService) LocationUUID() string {
+	return ""
+}
+
+//CurrentPrice returns the current price of the object
+func (p PaaSService) CurrentPrice() float64 {
+	return p.Properties.CurrentPrice
+}
+
+//Kind returns KindPaaSSecurityZone
+func (z PaaSSecurityZone) Kind() ObjectKind {
+	return KindPaaSSecurityZone
+}
+
+//UUID returns the UUID of the object
+func (z PaaSSecurityZone) UUID() string {
+	return z.Properties.ObjectUUID
+}
+
+//Name returns the name of the object
+func (z PaaSSecurityZone) Name() string {
+	return z.Properties.Name
+}
+
+//Labels returns the labels of the object
+func (z PaaSSecurityZone) Labels() []string {
+	return z.Properties.Labels
+}
+
+//Status returns the status of the object
+func (z PaaSSecurityZone) Status() string {
+	return z.Properties.Status
+}
+
+//LocationUUID returns the UUID of the object's location
+func (z PaaSSecurityZone) LocationUUID() string {
+	return z.Properties.LocationUUID
+}
+
+//CurrentPrice returns the current price of the object, which is always 0
+func (z PaaSSecurityZone) CurrentPrice() float64 {
+	return 0
+}
+
+//Kind returns KindLocation
+func (l Location) Kind() ObjectKind {
+	return KindLocation
+}
+
+//UUID returns the UUID of the object
+func (l Location) UUID() string {
+	return l.Properties.ObjectUUID
+}
+
+//Name returns the name of the object
+func (l Location) Name() string {
+	return l.Properties.Name
+}
+
+//Labels returns the labels of the object
+func (l Location) Labels() []string {
+	return l.Properties.Labels
+}
+
+//Status returns the status of the object
+func (l Location) Status() string {
+	return l.Properties.Status
+}
+
+//LocationUUID returns the UUID of the object's location, which is always empty
+func (l Location) LocationUUID() string {
+	return ""
+}
+
+//CurrentPrice returns the current price of the object, which is always 0
+func (l Location) CurrentPrice() float64 {
+	return 0
+}
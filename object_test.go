@@ -0,0 +1,118 @@
+package gsclient
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectKinds(t *testing.T) {
+	kinds := ObjectKinds()
+	assert.Equal(t, len(objectKinds), len(kinds))
+	assert.Equal(t, KindFirewall, kinds[0])
+	for _, kind := range kinds {
+		entry := objectKinds[kind]
+		assert.NotNil(t, entry.get, string(kind))
+		assert.NotNil(t, entry.list, string(kind))
+	}
+}
+
+func TestObject_Attributes(t *testing.T) {
+	objects := []Object{
+		Server{}, Storage{}, Network{}, IP{}, Sshkey{}, Template{}, LoadBalancer{}, ISOImage{},
+		Firewall{}, PaaSService{}, PaaSSecurityZone{}, Location{},
+	}
+	for _, object := range objects {
+		_, ok := objectKinds[object.Kind()]
+		assert.True(t, ok, string(object.Kind()))
+	}
+
+	var server Object = getMockServer(true)
+	assert.Equal(t, KindServer, server.Kind())
+	assert.Equal(t, dummyUUID, server.UUID())
+	assert.Equal(t, "Test", server.Name())
+	assert.Equal(t, []string{"label"}, server.Labels())
+	assert.Equal(t, "active", server.Status())
+	assert.Equal(t, dummyUUID, server.LocationUUID())
+	assert.Equal(t, 9.5, server.CurrentPrice())
+}
+
+func TestClient_GetObject(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiServerBase, dummyUUID)
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareServerHTTPGet(true))
+	})
+	object, err := client.GetObject(KindServer, dummyUUID)
+	if err != nil {
+		t.Errorf("GetObject returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("%v", getMockServer(true)), fmt.Sprintf("%v", object))
+
+	_, err = client.GetObject(ObjectKind("unknown"), dummyUUID)
+	assert.Error(t, err)
+}
+
+func TestClient_GetObjectList(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiStorageBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareStorageListHTTPGet())
+	})
+	objects, err := client.GetObjectList(KindStorage)
+	if err != nil {
+		t.Errorf("GetObjectList returned an error %v", err)
+	}
+	if assert.Equal(t, 1, len(objects)) {
+		assert.Equal(t, KindStorage, objects[0].Kind())
+		assert.Equal(t, fmt.Sprintf("%v", getMockStorage()), fmt.Sprintf("%v", objects[0]))
+	}
+
+	_, err = client.GetObjectList(ObjectKind("unknown"))
+	assert.Error(t, err)
+}
+
+func TestClient_DeleteObject(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiNetworkBase, dummyUUID)
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodDelete, request.Method)
+		fmt.Fprint(writer, "")
+	})
+	err := client.DeleteObject(KindNetwork, dummyUUID)
+	if err != nil {
+		t.Errorf("DeleteObject returned an error %v", err)
+	}
+
+	assert.Error(t, client.DeleteObject(KindLocation, dummyUUID))
+	assert.Error(t, client.DeleteObject(ObjectKind("unknown"), dummyUUID))
+}
+
+func TestClient_GetObjectEventList(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiServerBase, dummyUUID, "events")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareServerEventListHTTPGet())
+	})
+	events, err := client.GetObjectEventList(KindServer, dummyUUID)
+	if err != nil {
+		t.Errorf("GetObjectEventList returned an error %v", err)
+	}
+	if assert.Equal(t, 1, len(events)) {
+		serverEvent := getMockServerEvent().Properties
+		assert.Equal(t, EventProperties(serverEvent), events[0].Properties)
+	}
+
+	_, err = client.GetObjectEventList(KindPaaSService, dummyUUID)
+	assert.Error(t, err)
+}
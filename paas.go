@@ -193,9 +193,10 @@ type PaaSSecurityZoneCreateResponse struct {
 
 //PaaSSecurityZoneUpdateRequest JSON struct of a request for updating a PaaS security zone
 type PaaSSecurityZoneUpdateRequest struct {
-	Name                 string `json:"name,omitempty"`
-	LocationUUID         string `json:"location_uuid,omitempty"`
-	PaaSSecurityZoneUUID string `json:"paas_security_zone_uuid,omitempty"`
+	Name                 string   `json:"name,omitempty"`
+	LocationUUID         string   `json:"location_uuid,omitempty"`
+	PaaSSecurityZoneUUID string   `json:"paas_security_zone_uuid,omitempty"`
+	Labels               []string `json:"labels,omitempty"`
 }
 
 //GetPaaSServiceList returns a list of PaaS Services
@@ -212,9 +213,68 @@ func (c *Client) GetPaaSServiceList() ([]PaaSService, error) {
 			Properties: properties,
 		})
 	}
+	sortList(paasServices, SortByName, func(i int) listEntry {
+		return paasServices[i].listEntry()
+	})
 	return paasServices, err
 }
 
+//GetPaaSServiceListWithOptions gets a list of available PaaS services matching the given options
+func (c *Client) GetPaaSServiceListWithOptions(opts ListOptions) ([]PaaSService, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	paasServices, err := c.GetPaaSServiceList()
+	var filtered []PaaSService
+	for _, paasService := range paasServices {
+		if match(paasService.listEntry()) {
+			filtered = append(filtered, paasService)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetPaaSServiceMap gets the PaaS services keyed by their UUID
+func (c *Client) GetPaaSServiceMap() (map[string]PaaSService, error) {
+	paasServices, err := c.GetPaaSServiceList()
+	paasServiceMap := make(map[string]PaaSService, len(paasServices))
+	for _, paasService := range paasServices {
+		paasServiceMap[paasService.Properties.ObjectUUID] = paasService
+	}
+	return paasServiceMap, err
+}
+
+//GetPaaSServiceByName gets a PaaS service by its name. If no PaaS service has the name an ObjectNotFoundError is returned,
+//if several have it an AmbiguousNameError.
+func (c *Client) GetPaaSServiceByName(name string) (PaaSService, error) {
+	paasServices, err := c.GetPaaSServiceList()
+	if err != nil {
+		return PaaSService{}, err
+	}
+	i, err := findByName("PaaS service", name, len(paasServices), func(i int) listEntry {
+		return paasServices[i].listEntry()
+	})
+	if err != nil {
+		return PaaSService{}, err
+	}
+	return paasServices[i], nil
+}
+
+//listEntry returns the attributes list options are applied to
+func (p PaaSService) listEntry() listEntry {
+	return listEntry{
+		uuid:       p.Properties.ObjectUUID,
+		name:       p.Properties.Name,
+		createTime: p.Properties.CreateTime,
+		status:     p.Properties.Status,
+		labels:     p.Properties.Labels,
+	}
+}
+
 //CreatePaaSService creates a new PaaS service
 func (c *Client) CreatePaaSService(body PaaSServiceCreateRequest) (PaaSServiceCreateResponse, error) {
 	r := Request{
@@ -252,6 +312,28 @@ func (c *Client) UpdatePaaSService(id string, body PaaSServiceUpdateRequest) err
 	return r.execute(*c, nil)
 }
 
+//AddPaaSServiceLabels adds labels to a PaaS service. Labels with a key the PaaS service already has replace the existing ones
+func (c *Client) AddPaaSServiceLabels(id string, labels ...string) error {
+	paasService, err := c.GetPaaSService(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiPaaSBase, "services", id), paasService.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdatePaaSService(id, PaaSServiceUpdateRequest{Labels: labels})
+	})
+}
+
+//RemovePaaSServiceLabels removes the labels with the given keys from a PaaS service
+func (c *Client) RemovePaaSServiceLabels(id string, keys ...string) error {
+	paasService, err := c.GetPaaSService(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiPaaSBase, "services", id), paasService.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdatePaaSService(id, PaaSServiceUpdateRequest{Labels: labels})
+	})
+}
+
 //DeletePaaSService deletes a PaaS service
 func (c *Client) DeletePaaSService(id string) error {
 	r := Request{
@@ -293,9 +375,51 @@ func (c *Client) GetPaaSTemplateList() ([]PaaSTemplate, error) {
 		}
 		paasTemplates = append(paasTemplates, paasTemplate)
 	}
+	sortList(paasTemplates, SortByName, func(i int) listEntry {
+		return paasTemplates[i].listEntry()
+	})
 	return paasTemplates, err
 }
 
+//GetPaaSTemplateListWithOptions gets a list of PaaS service templates matching the given options
+func (c *Client) GetPaaSTemplateListWithOptions(opts ListOptions) ([]PaaSTemplate, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	paasTemplates, err := c.GetPaaSTemplateList()
+	var filtered []PaaSTemplate
+	for _, paasTemplate := range paasTemplates {
+		if match(paasTemplate.listEntry()) {
+			filtered = append(filtered, paasTemplate)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetPaaSTemplateMap gets the PaaS service templates keyed by their UUID
+func (c *Client) GetPaaSTemplateMap() (map[string]PaaSTemplate, error) {
+	paasTemplates, err := c.GetPaaSTemplateList()
+	paasTemplateMap := make(map[string]PaaSTemplate, len(paasTemplates))
+	for _, paasTemplate := range paasTemplates {
+		paasTemplateMap[paasTemplate.Properties.ObjectUUID] = paasTemplate
+	}
+	return paasTemplateMap, err
+}
+
+//listEntry returns the attributes list options are applied to
+func (p PaaSTemplate) listEntry() listEntry {
+	return listEntry{
+		uuid:   p.Properties.ObjectUUID,
+		name:   p.Properties.Name,
+		status: p.Properties.Status,
+		labels: p.Properties.Labels,
+	}
+}
+
 //GetPaaSSecurityZoneList get available security zones
 func (c *Client) GetPaaSSecurityZoneList() ([]PaaSSecurityZone, error) {
 	r := Request{
@@ -310,9 +434,53 @@ func (c *Client) GetPaaSSecurityZoneList() ([]PaaSSecurityZone, error) {
 			Properties: properties,
 		})
 	}
+	sortList(securityZones, SortByName, func(i int) listEntry {
+		return securityZones[i].listEntry()
+	})
 	return securityZones, err
 }
 
+//GetPaaSSecurityZoneListWithOptions gets a list of PaaS security zones matching the given options
+func (c *Client) GetPaaSSecurityZoneListWithOptions(opts ListOptions) ([]PaaSSecurityZone, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	securityZones, err := c.GetPaaSSecurityZoneList()
+	var filtered []PaaSSecurityZone
+	for _, securityZone := range securityZones {
+		if match(securityZone.listEntry()) {
+			filtered = append(filtered, securityZone)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetPaaSSecurityZoneMap gets the PaaS security zones keyed by their UUID
+func (c *Client) GetPaaSSecurityZoneMap() (map[string]PaaSSecurityZone, error) {
+	securityZones, err := c.GetPaaSSecurityZoneList()
+	securityZoneMap := make(map[string]PaaSSecurityZone, len(securityZones))
+	for _, securityZone := range securityZones {
+		securityZoneMap[securityZone.Properties.ObjectUUID] = securityZone
+	}
+	return securityZoneMap, err
+}
+
+//listEntry returns the attributes list options are applied to
+func (z PaaSSecurityZone) listEntry() listEntry {
+	return listEntry{
+		uuid:         z.Properties.ObjectUUID,
+		name:         z.Properties.Name,
+		createTime:   z.Properties.CreateTime,
+		locationUUID: z.Properties.LocationUUID,
+		status:       z.Properties.Status,
+		labels:       z.Properties.Labels,
+	}
+}
+
 //CreatePaaSSecurityZone creates a new PaaS security zone
 func (c *Client) CreatePaaSSecurityZone(body PaaSSecurityZoneCreateRequest) (PaaSSecurityZoneCreateResponse, error) {
 	r := Request{
@@ -350,6 +518,28 @@ func (c *Client) UpdatePaaSSecurityZone(id string, body PaaSSecurityZoneUpdateRe
 	return r.execute(*c, nil)
 }
 
+//AddPaaSSecurityZoneLabels adds labels to a PaaS security zone. Labels with a key the PaaS security zone already has replace the existing ones
+func (c *Client) AddPaaSSecurityZoneLabels(id string, labels ...string) error {
+	securityZone, err := c.GetPaaSSecurityZone(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiPaaSBase, "security_zones", id), securityZone.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdatePaaSSecurityZone(id, PaaSSecurityZoneUpdateRequest{Labels: labels})
+	})
+}
+
+//RemovePaaSSecurityZoneLabels removes the labels with the given keys from a PaaS security zone
+func (c *Client) RemovePaaSSecurityZoneLabels(id string, keys ...string) error {
+	securityZone, err := c.GetPaaSSecurityZone(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiPaaSBase, "security_zones", id), securityZone.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdatePaaSSecurityZone(id, PaaSSecurityZoneUpdateRequest{Labels: labels})
+	})
+}
+
 //DeletePaaSSecurityZone delete a specific PaaS Security Zone based on given id
 func (c *Client) DeletePaaSSecurityZone(id string) error {
 	r := Request{
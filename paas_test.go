@@ -26,6 +26,66 @@ func TestClient_GetPaaSServiceList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", expectedObj), fmt.Sprintf("%v", paasList))
 }
 
+func TestClient_GetPaaSServiceListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiPaaSBase, "services")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, preparePaaSHTTPGetListResponse())
+	})
+	res, err := client.GetPaaSServiceListWithOptions(ListOptions{NamePattern: "*", LabelSelector: "!deprecated"})
+	if err != nil {
+		t.Errorf("GetPaaSServiceListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetPaaSServiceListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetPaaSServiceListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetPaaSServiceListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetPaaSServiceMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiPaaSBase, "services")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, preparePaaSHTTPGetListResponse())
+	})
+	res, err := client.GetPaaSServiceMap()
+	if err != nil {
+		t.Errorf("GetPaaSServiceMap returned an error %v", err)
+	}
+	mock := getMockPaaSService()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
+func TestClient_GetPaaSServiceByName(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiPaaSBase, "services")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, preparePaaSHTTPGetListResponse())
+	})
+	mock := getMockPaaSService()
+	res, err := client.GetPaaSServiceByName(mock.Properties.Name)
+	if err != nil {
+		t.Errorf("GetPaaSServiceByName returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res))
+
+	_, err = client.GetPaaSServiceByName("unknown")
+	assert.IsType(t, ObjectNotFoundError{}, err)
+}
+
 func TestClient_GetPaaSService(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -101,6 +161,30 @@ func TestClient_UpdatePaaSService(t *testing.T) {
 	}
 }
 
+func TestClient_AddPaaSServiceLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiPaaSBase, "services", dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, preparePaaSHTTPGetResponse())
+	err := client.AddPaaSServiceLabels(dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddPaaSServiceLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"label", "env=prod"}}, *updates)
+}
+
+func TestClient_RemovePaaSServiceLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiPaaSBase, "services", dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, preparePaaSHTTPGetResponse())
+	err := client.RemovePaaSServiceLabels(dummyUUID, "label")
+	if err != nil {
+		t.Errorf("RemovePaaSServiceLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{}}, *updates)
+}
+
 func TestClient_DeletePaaSService(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -147,6 +231,47 @@ func TestClient_GetPaaSTemplateList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockPaasTemplate()), fmt.Sprintf("%v", res))
 }
 
+func TestClient_GetPaaSTemplateListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiPaaSBase, "service_templates")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, preparePaaSHTTPGetTemplatesResponse())
+	})
+	res, err := client.GetPaaSTemplateListWithOptions(ListOptions{NamePattern: "*", LabelSelector: "!deprecated", SortBy: SortByCreateTime})
+	if err != nil {
+		t.Errorf("GetPaaSTemplateListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetPaaSTemplateListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetPaaSTemplateListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetPaaSTemplateListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetPaaSTemplateMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiPaaSBase, "service_templates")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, preparePaaSHTTPGetTemplatesResponse())
+	})
+	res, err := client.GetPaaSTemplateMap()
+	if err != nil {
+		t.Errorf("GetPaaSTemplateMap returned an error %v", err)
+	}
+	mock := getMockPaasTemplate()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
 func TestClient_GetSecurityZoneList(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -164,6 +289,47 @@ func TestClient_GetSecurityZoneList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockSecurityZone()), fmt.Sprintf("%v", res))
 }
 
+func TestClient_GetPaaSSecurityZoneListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiPaaSBase, "security_zones")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, preparePaaSHTTPGetSecurityZoneList())
+	})
+	res, err := client.GetPaaSSecurityZoneListWithOptions(ListOptions{NamePattern: "*", LabelSelector: "!deprecated", SortBy: SortByCreateTime})
+	if err != nil {
+		t.Errorf("GetPaaSSecurityZoneListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetPaaSSecurityZoneListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetPaaSSecurityZoneListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetPaaSSecurityZoneListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetPaaSSecurityZoneMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiPaaSBase, "security_zones")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, preparePaaSHTTPGetSecurityZoneList())
+	})
+	res, err := client.GetPaaSSecurityZoneMap()
+	if err != nil {
+		t.Errorf("GetPaaSSecurityZoneMap returned an error %v", err)
+	}
+	mock := getMockSecurityZone()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
 func TestClient_CreatePaaSSecurityZone(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -219,6 +385,30 @@ func TestClient_UpdatePaaSSecurityZone(t *testing.T) {
 	}
 }
 
+func TestClient_AddPaaSSecurityZoneLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiPaaSBase, "security_zones", dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, preparePaaSHTTPGetSecurityZone())
+	err := client.AddPaaSSecurityZoneLabels(dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddPaaSSecurityZoneLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"label", "env=prod"}}, *updates)
+}
+
+func TestClient_RemovePaaSSecurityZoneLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiPaaSBase, "security_zones", dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, preparePaaSHTTPGetSecurityZone())
+	err := client.RemovePaaSSecurityZoneLabels(dummyUUID, "label")
+	if err != nil {
+		t.Errorf("RemovePaaSSecurityZoneLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{}}, *updates)
+}
+
 func TestClient_DeletePaaSSecurityZone(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
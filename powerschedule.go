@@ -0,0 +1,287 @@
+package gsclient
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//PowerScheduleLabel is the key of the label holding the power schedule of a server
+const PowerScheduleLabel = "power-schedule"
+
+//DefaultPowerControllerInterval is the time between two ticks of a power controller if its Interval isn't set
+const DefaultPowerControllerInterval = time.Minute
+
+//weekdays maps the abbreviations used in power schedules to weekdays
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+//PowerSchedule the days and the time of day a server should run
+type PowerSchedule struct {
+	Days [7]bool
+	//Start and End minutes since midnight. If End is before Start, the server runs over midnight until
+	//End of the next day.
+	Start    int
+	End      int
+	Location *time.Location
+}
+
+//ParsePowerSchedule parses a schedule like "mon-fri 08:00-19:00 Europe/Berlin". Days are a comma separated list
+//of days and ranges of days, e.g. "mon,wed,fri" or "mon-fri,sun". The time zone is optional, UTC by default.
+func ParsePowerSchedule(schedule string) (PowerSchedule, error) {
+	fields := strings.Fields(schedule)
+	if len(fields) < 2 || len(fields) > 3 {
+		return PowerSchedule{}, fmt.Errorf("invalid power schedule %q, expected days, time range and time zone", schedule)
+	}
+	var s PowerSchedule
+	for _, days := range strings.Split(fields[0], ",") {
+		bounds := strings.SplitN(days, "-", 2)
+		first, ok := weekdays[strings.ToLower(bounds[0])]
+		last := first
+		if ok && len(bounds) == 2 {
+			last, ok = weekdays[strings.ToLower(bounds[1])]
+		}
+		if !ok {
+			return PowerSchedule{}, fmt.Errorf("invalid days %q in power schedule %q", days, schedule)
+		}
+		for day := first; ; day = (day + 1) % 7 {
+			s.Days[day] = true
+			if day == last {
+				break
+			}
+		}
+	}
+	times := strings.SplitN(fields[1], "-", 2)
+	if len(times) != 2 {
+		return PowerSchedule{}, fmt.Errorf("invalid time range %q in power schedule %q", fields[1], schedule)
+	}
+	var err error
+	if s.Start, err = parseTimeOfDay(times[0]); err != nil {
+		return PowerSchedule{}, err
+	}
+	if s.End, err = parseTimeOfDay(times[1]); err != nil {
+		return PowerSchedule{}, err
+	}
+	//An empty time range would shut the server down for good, which is rather a typo than intended
+	if s.Start == s.End {
+		return PowerSchedule{}, fmt.Errorf("empty time range %q in power schedule %q", fields[1], schedule)
+	}
+	s.Location = time.UTC
+	if len(fields) == 3 {
+		if s.Location, err = time.LoadLocation(fields[2]); err != nil {
+			return PowerSchedule{}, fmt.Errorf("invalid time zone in power schedule %q: %v", schedule, err)
+		}
+	}
+	return s, nil
+}
+
+//parseTimeOfDay parses a time like "08:00" into minutes since midnight, "24:00" is the end of the day
+func parseTimeOfDay(value string) (int, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) == 2 {
+		hours, hoursErr := strconv.Atoi(parts[0])
+		minutes, minutesErr := strconv.Atoi(parts[1])
+		minute := hours*60 + minutes
+		if hoursErr == nil && minutesErr == nil && minutes >= 0 && minutes < 60 && minute >= 0 && minute <= 24*60 {
+			return minute, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid time of day %q in power schedule", value)
+}
+
+//Active reports whether a server should run at the time t
+func (s PowerSchedule) Active(t time.Time) bool {
+	location := s.Location
+	if location == nil {
+		location = time.UTC
+	}
+	t = t.In(location)
+	minute := t.Hour()*60 + t.Minute()
+	day := t.Weekday()
+	if s.Start <= s.End {
+		return s.Days[day] && minute >= s.Start && minute < s.End
+	}
+	//The time range spans midnight, the early part belongs to the previous day
+	return (s.Days[day] && minute >= s.Start) || (s.Days[(day+6)%7] && minute < s.End)
+}
+
+//PowerScheduleRule a schedule for all servers matching the label selector, see ParseLabelSelector
+type PowerScheduleRule struct {
+	LabelSelector string
+	Schedule      PowerSchedule
+}
+
+//PowerAction a server started or stopped by a power controller
+type PowerAction struct {
+	ServerUUID string
+	ServerName string
+	//Start the server is started, otherwise it's shut down
+	Start bool
+	//Err the error of starting or shutting down the server
+	Err error
+}
+
+//String returns the action as text
+func (a PowerAction) String() string {
+	action := "stop"
+	if a.Start {
+		action = "start"
+	}
+	if a.Err != nil {
+		return fmt.Sprintf("%s %s (%s) failed: %v", action, a.ServerUUID, a.ServerName, a.Err)
+	}
+	return fmt.Sprintf("%s %s (%s)", action, a.ServerUUID, a.ServerName)
+}
+
+//PowerSummary the result of a tick of a power controller. Servers is the number of servers with a schedule,
+//Invalid holds the errors of servers with a schedule label which cannot be parsed, keyed by UUID.
+type PowerSummary struct {
+	Time    time.Time
+	DryRun  bool
+	Servers int
+	Actions []PowerAction
+	Invalid BulkError
+}
+
+//String returns the summary as text
+func (s PowerSummary) String() string {
+	lines := []string{fmt.Sprintf("%s: %d servers with a power schedule, %d actions", s.Time.Format(time.RFC3339), s.Servers, len(s.Actions))}
+	if s.DryRun {
+		lines[0] += " (dry-run)"
+	}
+	for _, action := range s.Actions {
+		lines = append(lines, action.String())
+	}
+	if len(s.Invalid) > 0 {
+		lines = append(lines, s.Invalid.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+//PowerController starts and shuts down servers according to their power schedules. The schedule of a server is
+//the value of its label PowerScheduleLabel, or else the schedule of the first rule matching its labels.
+//Servers without a schedule aren't touched.
+type PowerController struct {
+	client *Client
+	now    func() time.Time
+	//Rules schedules of servers without a schedule label
+	Rules []PowerScheduleRule
+	//Shutdown policy of shutting down servers, the zero value shuts down gracefully and powers off on failure
+	Shutdown ShutdownPolicy
+	//DryRun only reports the actions
+	DryRun bool
+	//Interval time between two ticks of Run, DefaultPowerControllerInterval if zero
+	Interval time.Duration
+	//Report is called with the summary and the error of every tick of Run
+	Report func(PowerSummary, error)
+}
+
+//NewPowerController creates a power controller for the servers of the client
+func NewPowerController(c *Client) *PowerController {
+	return &PowerController{client: c, now: time.Now}
+}
+
+//Run ticks until ctx is cancelled, the summaries are passed to Report
+func (p *PowerController) Run(ctx context.Context) error {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = DefaultPowerControllerInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for ctx.Err() == nil {
+		summary, err := p.Tick()
+		if err != nil {
+			p.client.cfg.logger.Errorf("Power controller tick failed: %v", err)
+		}
+		if p.Report != nil {
+			p.Report(summary, err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+		}
+	}
+	return ctx.Err()
+}
+
+//Tick starts the servers which should run and shuts down the servers which shouldn't at the current time.
+//The servers are started and shut down concurrently, see Config.MaxConcurrency.
+func (p *PowerController) Tick() (PowerSummary, error) {
+	summary := PowerSummary{Time: p.now(), DryRun: p.DryRun, Invalid: BulkError{}}
+	selectors := make([]LabelSelector, len(p.Rules))
+	for i, rule := range p.Rules {
+		selector, err := ParseLabelSelector(rule.LabelSelector)
+		if err != nil {
+			return summary, err
+		}
+		selectors[i] = selector
+	}
+	servers, err := p.client.GetServerList()
+	if err != nil {
+		return summary, err
+	}
+
+	for _, server := range servers {
+		properties := server.Properties
+		schedule, ok, err := p.schedule(properties.Labels, selectors)
+		if err != nil {
+			summary.Invalid[properties.ObjectUUID] = err
+			continue
+		}
+		if !ok {
+			continue
+		}
+		summary.Servers++
+		if active := schedule.Active(summary.Time); active != properties.Power {
+			summary.Actions = append(summary.Actions, PowerAction{
+				ServerUUID: properties.ObjectUUID,
+				ServerName: properties.Name,
+				Start:      active,
+			})
+		}
+	}
+	sort.Slice(summary.Actions, func(i, j int) bool {
+		return summary.Actions[i].ServerUUID < summary.Actions[j].ServerUUID
+	})
+	if p.DryRun {
+		return summary, nil
+	}
+
+	p.client.forEachConcurrently(len(summary.Actions), func(i int) error {
+		action := &summary.Actions[i]
+		if action.Start {
+			action.Err = p.client.StartServer(action.ServerUUID)
+		} else {
+			action.Err = p.client.ShutdownServerWithPolicy(action.ServerUUID, p.Shutdown)
+		}
+		return action.Err
+	})
+	return summary, nil
+}
+
+//schedule returns the schedule of a server with the labels, ok is false if the server has no schedule
+func (p *PowerController) schedule(labels []string, selectors []LabelSelector) (schedule PowerSchedule, ok bool, err error) {
+	for _, label := range labels {
+		if key, value := splitLabel(label); key == PowerScheduleLabel {
+			schedule, err = ParsePowerSchedule(value)
+			return schedule, err == nil, err
+		}
+	}
+	for i, selector := range selectors {
+		if selector.Matches(labels) {
+			return p.Rules[i].Schedule, true, nil
+		}
+	}
+	return PowerSchedule{}, false, nil
+}
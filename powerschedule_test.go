@@ -0,0 +1,151 @@
+package gsclient
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePowerSchedule(t *testing.T) {
+	schedule, err := ParsePowerSchedule("mon-fri 08:00-19:00 Europe/Berlin")
+	if err != nil {
+		t.Fatalf("ParsePowerSchedule returned an error %v", err)
+	}
+	assert.Equal(t, [7]bool{false, true, true, true, true, true, false}, schedule.Days)
+	assert.Equal(t, 8*60, schedule.Start)
+	assert.Equal(t, 19*60, schedule.End)
+	assert.Equal(t, "Europe/Berlin", schedule.Location.String())
+
+	schedule, err = ParsePowerSchedule("fri-mon,Wed 22:30-24:00")
+	if err != nil {
+		t.Fatalf("ParsePowerSchedule returned an error %v", err)
+	}
+	assert.Equal(t, [7]bool{true, true, false, true, false, true, true}, schedule.Days)
+	assert.Equal(t, 24*60, schedule.End)
+	assert.Equal(t, time.UTC, schedule.Location)
+
+	for _, invalid := range []string{"", "mon-fri", "mon-fri 08:00", "mon-xyz 08:00-19:00", "mon 8-19", "mon 08:60-19:00",
+		"mon 08:00-25:00", "mon 08:00-19:00 Mars/Olympus", "mon 08:00-19:00 UTC extra", "mon-fri 08:00-08:00"} {
+		_, err = ParsePowerSchedule(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestPowerSchedule_Active(t *testing.T) {
+	office, _ := ParsePowerSchedule("mon-fri 08:00-19:00 Europe/Berlin")
+	night, _ := ParsePowerSchedule("fri 22:00-06:00")
+	testCases := []struct {
+		schedule PowerSchedule
+		time     string
+		active   bool
+	}{
+		//2019-07-01 is a Monday, Berlin is UTC+2 in summer
+		{office, "2019-07-01T06:00:00Z", true},
+		{office, "2019-07-01T05:59:00Z", false},
+		{office, "2019-07-01T16:59:00Z", true},
+		{office, "2019-07-01T17:00:00Z", false},
+		{office, "2019-07-06T10:00:00Z", false},
+		{night, "2019-07-05T21:59:00Z", false},
+		{night, "2019-07-05T22:00:00Z", true},
+		{night, "2019-07-06T05:59:00Z", true},
+		{night, "2019-07-06T06:00:00Z", false},
+		{night, "2019-07-06T23:00:00Z", false},
+		{night, "2019-07-04T23:00:00Z", false},
+	}
+	for _, test := range testCases {
+		now, _ := time.Parse(time.RFC3339, test.time)
+		assert.Equal(t, test.active, test.schedule.Active(now), test.time)
+	}
+}
+
+func TestPowerController_Tick(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, apiServerBase, `{"servers": {
+		"a": {"object_uuid": "a", "name": "dev-1", "power": true, "labels": ["power-schedule=mon-fri 08:00-19:00 Europe/Berlin"]},
+		"b": {"object_uuid": "b", "name": "dev-2", "power": false, "labels": ["env=dev"]},
+		"c": {"object_uuid": "c", "name": "dev-3", "power": true, "labels": ["power-schedule=someday"]},
+		"d": {"object_uuid": "d", "name": "prod", "power": true, "labels": ["env=prod"]},
+		"e": {"object_uuid": "e", "name": "dev-4", "power": false, "labels": ["power-schedule=mon-fri 08:00-19:00 Europe/Berlin"]}
+	}}`)
+	api.setPower("a", true)
+	api.setPower("b", false)
+	always, _ := ParsePowerSchedule("mon-sun 00:00-24:00")
+
+	controller := NewPowerController(client)
+	controller.Rules = []PowerScheduleRule{{LabelSelector: "env=dev", Schedule: always}}
+	controller.Shutdown = ShutdownPolicy{Mode: ShutdownForce}
+	controller.DryRun = true
+	//A Saturday
+	controller.now = func() time.Time {
+		return time.Date(2019, 7, 6, 10, 0, 0, 0, time.UTC)
+	}
+
+	summary, err := controller.Tick()
+	if err != nil {
+		t.Fatalf("Tick returned an error %v", err)
+	}
+	assert.Equal(t, `2019-07-06T10:00:00Z: 3 servers with a power schedule, 2 actions (dry-run)
+stop a (dev-1)
+start b (dev-2)
+1 of the objects have failed: c: invalid power schedule "someday", expected days, time range and time zone`, summary.String())
+	assert.Equal(t, 0, len(api.recorded()))
+
+	controller.DryRun = false
+	summary, err = controller.Tick()
+	if err != nil {
+		t.Fatalf("Tick returned an error %v", err)
+	}
+	for _, action := range summary.Actions {
+		assert.Nil(t, action.Err)
+	}
+	assert.ElementsMatch(t, []string{"PATCH /objects/servers/a/power", "PATCH /objects/servers/b/power"}, api.recorded())
+
+	controller.Rules = []PowerScheduleRule{{LabelSelector: "=dev"}}
+	_, err = controller.Tick()
+	assert.Error(t, err)
+}
+
+func TestPowerController_Run(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, apiServerBase, `{"servers": {}}`)
+
+	controller := NewPowerController(client)
+	controller.Interval = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	ticks := 0
+	controller.Report = func(summary PowerSummary, err error) {
+		assert.Nil(t, err)
+		ticks++
+		if ticks == 3 {
+			cancel()
+		}
+	}
+	assert.Equal(t, context.Canceled, controller.Run(ctx))
+	assert.Equal(t, 3, ticks)
+}
+
+func TestPowerController_TickEmptyTimeRange(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, apiServerBase, `{"servers": {
+		"a": {"object_uuid": "a", "name": "dev-1", "power": true, "labels": ["power-schedule=mon-fri 08:00-08:00"]}
+	}}`)
+
+	//The server isn't shut down because of a typo in its schedule
+	summary, err := NewPowerController(client).Tick()
+	if err != nil {
+		t.Fatalf("Tick returned an error %v", err)
+	}
+	assert.Equal(t, 0, summary.Servers)
+	assert.Empty(t, summary.Actions)
+	assert.Error(t, summary.Invalid["a"])
+	assert.Empty(t, api.recorded())
+}
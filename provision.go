@@ -0,0 +1,233 @@
+package gsclient
+
+import (
+	"context"
+	"fmt"
+)
+
+//ServerSpec specification of a server created by ProvisionServer
+type ServerSpec struct {
+	Name         string
+	Cores        int
+	Memory       int
+	LocationUUID string
+	//AvailablityZone availability zone of the server in the location, chosen by the API if empty
+	AvailablityZone string
+	Labels          []string
+	//TemplateUUID template of the boot storage, no storage is created if empty
+	TemplateUUID string
+	//StorageCapacity capacity of the boot storage in GB
+	StorageCapacity int
+	StorageType     string
+	//SshkeyUUIDs SSH-keys installed by the template
+	SshkeyUUIDs []string
+	//Password password set by the template, PasswordType is "plain" or "crypt"
+	Password     string
+	PasswordType string
+	//Hostname hostname set by the template
+	Hostname string
+	//NetworkUUIDs networks linked to the server in this order
+	NetworkUUIDs []string
+	//IPFamilies a new IP address is created for each family (4 or 6) and linked to the server
+	IPFamilies []int
+	//IPUUIDs existing IP addresses linked to the server
+	IPUUIDs []string
+	//ISOImageUUID ISO-Image linked to the server
+	ISOImageUUID string
+	//SkipStart doesn't start the server once it's set up
+	SkipStart bool
+	//Readiness waits until the started server is ready, only until it's running if nil
+	Readiness *ReadinessOptions
+}
+
+//ProvisionedServer the objects created by ProvisionServer
+type ProvisionedServer struct {
+	ServerUUID  string
+	StorageUUID string
+	//IPUUIDs the IP addresses created for ServerSpec.IPFamilies
+	IPUUIDs []string
+}
+
+//ProvisionError error of a failed provisioning. Step is the step that has failed. The objects created
+//so far have been deleted and linked objects unlinked again; RollbackErr is a BulkError keyed by every
+//undo step which has failed, e.g. "delete IP <uuid>", nil if the rollback has succeeded.
+type ProvisionError struct {
+	Step        string
+	Err         error
+	RollbackErr error
+}
+
+//Error returns the error as string
+func (e ProvisionError) Error() string {
+	if e.RollbackErr != nil {
+		return fmt.Sprintf("provisioning failed at step %q: %v, rollback failed: %v", e.Step, e.Err, e.RollbackErr)
+	}
+	return fmt.Sprintf("provisioning failed at step %q: %v", e.Step, e.Err)
+}
+
+//undoStep compensates a step of a workflow, name describes what it does with which object,
+//e.g. "unlink IP <uuid>", and is unique within a workflow
+type undoStep struct {
+	name string
+	undo func() error
+}
+
+//rollback runs the undo steps in reverse order, a failing step doesn't stop the others.
+//It returns a BulkError keyed by the names of the steps which have failed.
+func rollback(steps []undoStep) error {
+	failed := BulkError{}
+	for i := len(steps) - 1; i >= 0; i-- {
+		if err := steps[i].undo(); err != nil {
+			failed[steps[i].name] = err
+		}
+	}
+	if len(failed) > 0 {
+		return failed
+	}
+	return nil
+}
+
+//ProvisionServer creates a ready to use server in one call: it creates the boot storage from a template,
+//creates the server, links storage, networks, IP addresses and ISO-Image, creates new IP addresses and
+//starts the server. Every step waits for its request to complete, with Readiness set the last step waits
+//until the server is ready.
+//
+//If a step fails, all objects created so far are deleted and the links to existing objects are removed
+//in reverse order, the error is a ProvisionError.
+func (c *Client) ProvisionServer(spec ServerSpec) (ProvisionedServer, error) {
+	var result ProvisionedServer
+	var undo []undoStep
+	fail := func(step string, err error) (ProvisionedServer, error) {
+		c.cfg.logger.Errorf("Provisioning of server %v failed at step %v: %v", spec.Name, step, err)
+		return ProvisionedServer{}, ProvisionError{Step: step, Err: err, RollbackErr: rollback(undo)}
+	}
+
+	if spec.TemplateUUID != "" {
+		storage, err := c.CreateStorage(StorageCreateRequest{
+			Name:         spec.Name,
+			Capacity:     spec.StorageCapacity,
+			LocationUUID: spec.LocationUUID,
+			StorageType:  spec.StorageType,
+			Labels:       spec.Labels,
+			Template: &StorageTemplate{
+				TemplateUUID: spec.TemplateUUID,
+				Sshkeys:      spec.SshkeyUUIDs,
+				Password:     spec.Password,
+				PasswordType: spec.PasswordType,
+				Hostname:     spec.Hostname,
+			},
+		})
+		//A storage is created even if waiting for the request has failed
+		if storage.ObjectUUID != "" {
+			undo = append(undo, undoStep{"delete storage " + storage.ObjectUUID, func() error {
+				return c.DeleteStorage(storage.ObjectUUID)
+			}})
+		}
+		if err != nil {
+			return fail("create storage", err)
+		}
+		result.StorageUUID = storage.ObjectUUID
+	}
+
+	server, err := c.CreateServer(ServerCreateRequest{
+		Name:            spec.Name,
+		Cores:           spec.Cores,
+		Memory:          spec.Memory,
+		LocationUUID:    spec.LocationUUID,
+		AvailablityZone: spec.AvailablityZone,
+		Labels:          spec.Labels,
+	})
+	serverID := server.ObjectUUID
+	if serverID != "" {
+		undo = append(undo, undoStep{"delete server " + serverID, func() error {
+			return c.DeleteServer(serverID)
+		}})
+	}
+	if err != nil {
+		return fail("create server", err)
+	}
+	result.ServerUUID = serverID
+
+	if result.StorageUUID != "" {
+		storageID := result.StorageUUID
+		if err := c.LinkStorage(serverID, storageID, true); err != nil {
+			return fail("link storage", err)
+		}
+		undo = append(undo, undoStep{"unlink storage " + storageID, func() error {
+			return c.UnlinkStorage(serverID, storageID)
+		}})
+	}
+
+	for i, networkID := range spec.NetworkUUIDs {
+		networkID := networkID
+		if err := c.LinkNetwork(serverID, networkID, "", false, i, nil, FirewallRules{}); err != nil {
+			return fail("link network", err)
+		}
+		undo = append(undo, undoStep{"unlink network " + networkID, func() error {
+			return c.UnlinkNetwork(serverID, networkID)
+		}})
+	}
+
+	for _, family := range spec.IPFamilies {
+		ip, err := c.CreateIP(IPCreateRequest{
+			Name:         spec.Name,
+			Family:       family,
+			LocationUUID: spec.LocationUUID,
+			Labels:       spec.Labels,
+		})
+		ipID := ip.ObjectUUID
+		if ipID != "" {
+			undo = append(undo, undoStep{"delete IP " + ipID, func() error {
+				return c.DeleteIP(ipID)
+			}})
+		}
+		if err != nil {
+			return fail("create IP", err)
+		}
+		result.IPUUIDs = append(result.IPUUIDs, ipID)
+		if err := c.LinkIP(serverID, ipID); err != nil {
+			return fail("link IP", err)
+		}
+		undo = append(undo, undoStep{"unlink IP " + ipID, func() error {
+			return c.UnlinkIP(serverID, ipID)
+		}})
+	}
+	for _, ipID := range spec.IPUUIDs {
+		ipID := ipID
+		if err := c.LinkIP(serverID, ipID); err != nil {
+			return fail("link IP", err)
+		}
+		undo = append(undo, undoStep{"unlink IP " + ipID, func() error {
+			return c.UnlinkIP(serverID, ipID)
+		}})
+	}
+
+	if spec.ISOImageUUID != "" {
+		isoImageID := spec.ISOImageUUID
+		if err := c.LinkIsoImage(serverID, isoImageID); err != nil {
+			return fail("link ISO-Image", err)
+		}
+		undo = append(undo, undoStep{"unlink ISO-Image " + isoImageID, func() error {
+			return c.UnlinkIsoImage(serverID, isoImageID)
+		}})
+	}
+
+	if !spec.SkipStart {
+		if err := c.StartServer(serverID); err != nil {
+			//The server may have been started although the request has failed
+			undo = append(undo, undoStep{"stop server " + serverID, func() error {
+				return c.StopServer(serverID)
+			}})
+			return fail("start server", err)
+		}
+		if spec.Readiness != nil {
+			if err := c.WaitForServerReady(context.Background(), serverID, *spec.Readiness); err != nil {
+				undo = append(undo, undoStep{"stop server " + serverID, func() error {
+					return c.StopServer(serverID)
+				}})
+				return fail("wait for readiness", err)
+			}
+		}
+	}
+	return result, nil
+}
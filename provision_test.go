@@ -0,0 +1,270 @@
+package gsclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//fakeAPI a stateful API for testing workflows. It answers every request to "/requests/" as done, keeps track
+//of the power state of servers, also in the responses set for them, and records all requests but GETs
+//as "METHOD path" together with their bodies.
+type fakeAPI struct {
+	mu        sync.Mutex
+	calls     []string
+	bodies    []string
+	responses map[string]string
+	failures  map[string]int
+	power     map[string]bool
+}
+
+//newFakeAPI registers a fake API on the mux
+func newFakeAPI(mux *http.ServeMux) *fakeAPI {
+	api := &fakeAPI{
+		responses: map[string]string{},
+		failures:  map[string]int{},
+		power:     map[string]bool{},
+	}
+	mux.HandleFunc("/", api.handle)
+	return api
+}
+
+//respond sets the response of a request
+func (api *fakeAPI) respond(method, uri, body string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.responses[method+" "+uri] = body
+}
+
+//fail lets a request fail with the status code
+func (api *fakeAPI) fail(method, uri string, statusCode int) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.failures[method+" "+uri] = statusCode
+}
+
+//setPower sets the power state of a server
+func (api *fakeAPI) setPower(id string, power bool) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.power[id] = power
+}
+
+//recordedBodies returns the bodies of the recorded requests
+func (api *fakeAPI) recordedBodies() []string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return append([]string(nil), api.bodies...)
+}
+
+//recorded returns the recorded requests
+func (api *fakeAPI) recorded() []string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return append([]string(nil), api.calls...)
+}
+
+func (api *fakeAPI) handle(writer http.ResponseWriter, request *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	uri := request.URL.Path
+	key := request.Method + " " + uri
+	if strings.HasPrefix(uri, "/requests/") {
+		fmt.Fprintf(writer, `{"%s": {"status": "done"}}`, path.Base(uri))
+		return
+	}
+	body, _ := ioutil.ReadAll(request.Body)
+	if request.Method != http.MethodGet {
+		api.calls = append(api.calls, key)
+		api.bodies = append(api.bodies, string(body))
+	}
+	if statusCode, ok := api.failures[key]; ok {
+		writer.WriteHeader(statusCode)
+		fmt.Fprintf(writer, `{"status": "failed", "message": "%s failed"}`, key)
+		return
+	}
+	id := path.Base(path.Dir(uri))
+	if response, ok := api.responses[key]; ok {
+		//The power state of servers is kept up to date
+		if power, ok := api.power[path.Base(uri)]; ok && request.Method == http.MethodGet && path.Dir(uri) == apiServerBase {
+			var server Server
+			json.Unmarshal([]byte(response), &server)
+			server.Properties.Power = power
+			res, _ := json.Marshal(server)
+			response = string(res)
+		}
+		fmt.Fprint(writer, response)
+		return
+	}
+	switch {
+	case request.Method == http.MethodPatch && path.Base(uri) == "power":
+		var powerUpdate ServerPowerUpdateRequest
+		json.Unmarshal(body, &powerUpdate)
+		api.power[id] = powerUpdate.Power
+	case request.Method == http.MethodPatch && path.Base(uri) == "shutdown":
+		api.power[id] = false
+	case request.Method == http.MethodGet && path.Dir(uri) == apiServerBase:
+		id = path.Base(uri)
+		fmt.Fprintf(writer, `{"server": {"object_uuid": "%s", "power": %t}}`, id, api.power[id])
+	}
+}
+
+func TestClient_ProvisionServer(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodPost, apiStorageBase, `{"object_uuid": "storage-1", "request_uuid": "r1"}`)
+	api.respond(http.MethodPost, apiServerBase, `{"object_uuid": "server-1", "request_uuid": "r2"}`)
+	api.respond(http.MethodPost, apiIPBase, `{"object_uuid": "ip-1", "request_uuid": "r3"}`)
+
+	result, err := client.ProvisionServer(ServerSpec{
+		Name:            "web",
+		Cores:           2,
+		Memory:          4,
+		LocationUUID:    dummyUUID,
+		TemplateUUID:    "template-1",
+		StorageCapacity: 10,
+		SshkeyUUIDs:     []string{"key-1"},
+		NetworkUUIDs:    []string{"network-1"},
+		IPFamilies:      []int{4},
+		IPUUIDs:         []string{"ip-2"},
+		ISOImageUUID:    "iso-1",
+	})
+	if err != nil {
+		t.Fatalf("ProvisionServer returned an error %v", err)
+	}
+	assert.Equal(t, ProvisionedServer{ServerUUID: "server-1", StorageUUID: "storage-1", IPUUIDs: []string{"ip-1"}}, result)
+	assert.Equal(t, []string{
+		"POST /objects/storages",
+		"POST /objects/servers",
+		"POST /objects/servers/server-1/storages",
+		"POST /objects/servers/server-1/networks",
+		"POST /objects/ips",
+		"POST /objects/servers/server-1/ips",
+		"POST /objects/servers/server-1/ips",
+		"POST /objects/servers/server-1/isoimages",
+		"PATCH /objects/servers/server-1/power",
+	}, api.recorded())
+}
+
+func TestClient_ProvisionServerRollback(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodPost, apiStorageBase, `{"object_uuid": "storage-1", "request_uuid": "r1"}`)
+	api.respond(http.MethodPost, apiServerBase, `{"object_uuid": "server-1", "request_uuid": "r2"}`)
+	api.respond(http.MethodPost, apiIPBase, `{"object_uuid": "ip-1", "request_uuid": "r3"}`)
+	api.fail(http.MethodPost, "/objects/servers/server-1/ips", http.StatusConflict)
+	api.fail(http.MethodDelete, "/objects/ips/ip-1", http.StatusInternalServerError)
+
+	_, err := client.ProvisionServer(ServerSpec{
+		Name:         "web",
+		TemplateUUID: "template-1",
+		NetworkUUIDs: []string{"network-1"},
+		IPFamilies:   []int{4},
+	})
+	provisionError, ok := err.(ProvisionError)
+	if !assert.True(t, ok) {
+		t.FailNow()
+	}
+	assert.Equal(t, "link IP", provisionError.Step)
+	assert.Equal(t, http.StatusConflict, provisionError.Err.(RequestError).StatusCode)
+	if rollbackErr, ok := provisionError.RollbackErr.(BulkError); assert.True(t, ok) {
+		assert.Equal(t, 1, len(rollbackErr))
+		assert.Error(t, rollbackErr["delete IP ip-1"])
+	}
+	assert.Equal(t, []string{
+		"POST /objects/storages",
+		"POST /objects/servers",
+		"POST /objects/servers/server-1/storages",
+		"POST /objects/servers/server-1/networks",
+		"POST /objects/ips",
+		"POST /objects/servers/server-1/ips",
+		"DELETE /objects/ips/ip-1",
+		"DELETE /objects/servers/server-1/networks/network-1",
+		"DELETE /objects/servers/server-1/storages/storage-1",
+		"DELETE /objects/servers/server-1",
+		"DELETE /objects/storages/storage-1",
+	}, api.recorded())
+}
+
+func TestClient_ProvisionServerRollbackErrors(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodPost, apiServerBase, `{"object_uuid": "server-1", "request_uuid": "r1"}`)
+	api.respond(http.MethodPost, apiIPBase, `{"object_uuid": "ip-1", "request_uuid": "r2"}`)
+	api.fail(http.MethodPost, "/objects/servers/server-1/isoimages", http.StatusConflict)
+	api.fail(http.MethodDelete, "/objects/servers/server-1/ips/ip-1", http.StatusInternalServerError)
+	api.fail(http.MethodDelete, "/objects/ips/ip-1", http.StatusInternalServerError)
+
+	_, err := client.ProvisionServer(ServerSpec{Name: "web", IPFamilies: []int{4}, ISOImageUUID: "iso-1"})
+	provisionError, ok := err.(ProvisionError)
+	if !assert.True(t, ok) {
+		t.FailNow()
+	}
+	assert.Equal(t, "link ISO-Image", provisionError.Step)
+	//Both failed steps of the same IP address are reported
+	if rollbackErr, ok := provisionError.RollbackErr.(BulkError); assert.True(t, ok) {
+		assert.Equal(t, 2, len(rollbackErr))
+		assert.Error(t, rollbackErr["unlink IP ip-1"])
+		assert.Error(t, rollbackErr["delete IP ip-1"])
+	}
+}
+
+func TestRollback(t *testing.T) {
+	var undone []string
+	step := func(id string, err error) undoStep {
+		return undoStep{id, func() error {
+			undone = append(undone, id)
+			return err
+		}}
+	}
+	assert.Nil(t, rollback([]undoStep{step("a", nil), step("b", nil)}))
+	assert.Equal(t, []string{"b", "a"}, undone)
+
+	undone = nil
+	err := rollback([]undoStep{step("a", fmt.Errorf("failed")), step("b", nil), step("c", fmt.Errorf("failed"))})
+	assert.Equal(t, []string{"c", "b", "a"}, undone)
+	assert.Equal(t, BulkError{"a": fmt.Errorf("failed"), "c": fmt.Errorf("failed")}, err)
+}
+
+func TestClient_ProvisionServerReadiness(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodPost, apiServerBase, `{"object_uuid": "server-1", "request_uuid": "r1"}`)
+
+	_, err := client.ProvisionServer(ServerSpec{
+		Name: "web",
+		Readiness: &ReadinessOptions{
+			Probe: func(ctx context.Context, server Server) error {
+				return errors.New("connection refused")
+			},
+			Timeout:  50 * time.Millisecond,
+			Interval: 10 * time.Millisecond,
+		},
+	})
+	provisionError, ok := err.(ProvisionError)
+	if !assert.True(t, ok) {
+		t.FailNow()
+	}
+	assert.Equal(t, "wait for readiness", provisionError.Step)
+	assert.Nil(t, provisionError.RollbackErr)
+	assert.Equal(t, []string{
+		"POST /objects/servers",
+		"PATCH /objects/servers/server-1/power",
+		"PATCH /objects/servers/server-1/power",
+		"DELETE /objects/servers/server-1",
+	}, api.recorded())
+}
@@ -0,0 +1,29 @@
+package gsclient
+
+import (
+	"sync"
+	"time"
+)
+
+//rateLimiter spaces requests evenly, so that no more than a given number of requests are sent per second
+type rateLimiter struct {
+	mu   sync.Mutex
+	next time.Time
+}
+
+//wait blocks until the next request may be sent
+func (l *rateLimiter) wait(requestsPerSecond float64) {
+	if requestsPerSecond <= 0 {
+		return
+	}
+	interval := time.Duration(float64(time.Second) / requestsPerSecond)
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(interval)
+	l.mu.Unlock()
+	time.Sleep(delay)
+}
@@ -0,0 +1,40 @@
+package gsclient
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	var limiter rateLimiter
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		limiter.wait(50)
+	}
+	//The first request is sent right away, the others 20ms apart
+	assert.True(t, time.Since(start) >= 80*time.Millisecond)
+
+	start = time.Now()
+	for i := 0; i < 5; i++ {
+		limiter.wait(0)
+	}
+	assert.True(t, time.Since(start) < 50*time.Millisecond)
+}
+
+func TestClient_RequestsPerSecond(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	client.cfg.RequestsPerSecond = 50
+	mux.HandleFunc(apiStorageBase+"/", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprintf(writer, `{"storage": {"object_uuid": "%s"}}`, path.Base(request.URL.Path))
+	})
+	start := time.Now()
+	_, err := client.GetStoragesByUUID([]string{"a", "b", "c", "d", "e"})
+	assert.Nil(t, err)
+	assert.True(t, time.Since(start) >= 80*time.Millisecond)
+}
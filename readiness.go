@@ -0,0 +1,127 @@
+package gsclient
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+)
+
+//DefaultReadinessTimeout is the time a server may take to become ready if ReadinessOptions.Timeout isn't set
+const DefaultReadinessTimeout = 5 * time.Minute
+
+//DefaultReadinessInterval is the time before the second probe if ReadinessOptions.Interval isn't set
+const DefaultReadinessInterval = time.Second
+
+//DefaultReadinessMaxInterval is the longest time between two probes if ReadinessOptions.MaxInterval isn't set
+const DefaultReadinessMaxInterval = 30 * time.Second
+
+//DefaultProbeTimeout is the time a single probe may take if ReadinessOptions.ProbeTimeout isn't set
+const DefaultProbeTimeout = 5 * time.Second
+
+//ReadinessProbe checks whether a running server is ready, it returns nil if it is
+type ReadinessProbe func(ctx context.Context, server Server) error
+
+//ReadinessOptions options of waiting for a server to become ready
+type ReadinessOptions struct {
+	//Probe is called until it succeeds, a server is ready once it's running if nil
+	Probe ReadinessProbe
+	//Timeout time the server may take to become ready, DefaultReadinessTimeout if zero
+	Timeout time.Duration
+	//ProbeTimeout time a single probe may take, DefaultProbeTimeout if zero
+	ProbeTimeout time.Duration
+	//Interval time before the second probe, DefaultReadinessInterval if zero. The interval doubles after
+	//every failed probe up to MaxInterval.
+	Interval time.Duration
+	//MaxInterval longest time between two probes, DefaultReadinessMaxInterval if zero
+	MaxInterval time.Duration
+}
+
+//TCPProbe is ready once the port accepts connections on one of the public IP addresses of the server
+func TCPProbe(port int) ReadinessProbe {
+	return func(ctx context.Context, server Server) error {
+		ips := server.Properties.Relations.PublicIPs
+		if len(ips) == 0 {
+			return fmt.Errorf("server %v has no public IP address", server.Properties.ObjectUUID)
+		}
+		var err error
+		for _, ip := range ips {
+			if err = dialTCP(ctx, net.JoinHostPort(ip.IP, strconv.Itoa(port))); err == nil {
+				return nil
+			}
+		}
+		return err
+	}
+}
+
+//TCPProbeAddress is ready once the address accepts connections, e.g. "10.0.0.5:22" for a private IP address
+func TCPProbeAddress(address string) ReadinessProbe {
+	return func(ctx context.Context, server Server) error {
+		return dialTCP(ctx, address)
+	}
+}
+
+//dialTCP opens a TCP connection to the address and closes it again
+func dialTCP(ctx context.Context, address string) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+//WaitForServerReady waits until a server is running and the probe succeeds. Between two probes the time
+//doubles, starting at Interval up to MaxInterval.
+func (c *Client) WaitForServerReady(ctx context.Context, id string, opts ReadinessOptions) error {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultReadinessTimeout
+	}
+	probeTimeout := opts.ProbeTimeout
+	if probeTimeout <= 0 {
+		probeTimeout = DefaultProbeTimeout
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultReadinessInterval
+	}
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultReadinessMaxInterval
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		server, err := c.GetServer(id)
+		switch {
+		case err != nil:
+		case !server.Properties.Power:
+			err = fmt.Errorf("server %v isn't running", id)
+		case opts.Probe != nil:
+			probeCtx, cancelProbe := context.WithTimeout(ctx, probeTimeout)
+			err = opts.Probe(probeCtx, server)
+			cancelProbe()
+		}
+		if err == nil {
+			c.cfg.logger.Infof("Server %v is ready", id)
+			return nil
+		}
+		c.cfg.logger.Debugf("Server %v isn't ready: %v", id, err)
+		if sleepErr := sleepContext(ctx, interval); sleepErr != nil {
+			return fmt.Errorf("server %v hasn't become ready: %v, last probe: %v", id, sleepErr, err)
+		}
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+//StartServerWithReadiness starts a server and waits until it's ready, see WaitForServerReady
+func (c *Client) StartServerWithReadiness(ctx context.Context, id string, opts ReadinessOptions) error {
+	if err := c.StartServer(id); err != nil {
+		return err
+	}
+	return c.WaitForServerReady(ctx, id, opts)
+}
@@ -0,0 +1,93 @@
+package gsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTCPProbe(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen returned an error %v", err)
+	}
+	defer listener.Close()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	var server Server
+	ctx := context.Background()
+	assert.Error(t, TCPProbe(port)(ctx, server))
+	server.Properties.Relations.PublicIPs = []ServerIPRelationProperties{{IP: "127.0.0.2"}, {IP: "127.0.0.1"}}
+	assert.NoError(t, TCPProbe(port)(ctx, server))
+	assert.NoError(t, TCPProbeAddress(listener.Addr().String())(ctx, server))
+
+	listener.Close()
+	assert.Error(t, TCPProbe(port)(ctx, server))
+	assert.Error(t, TCPProbeAddress("127.0.0.1:"+strconv.Itoa(port))(ctx, server))
+}
+
+func TestClient_WaitForServerReady(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.setPower("server-1", true)
+
+	var probes []time.Time
+	opts := ReadinessOptions{
+		Probe: func(ctx context.Context, server Server) error {
+			probes = append(probes, time.Now())
+			if _, ok := ctx.Deadline(); !ok {
+				return errors.New("probe without timeout")
+			}
+			if len(probes) < 4 {
+				return fmt.Errorf("probe %d failed", len(probes))
+			}
+			return nil
+		},
+		Interval:    10 * time.Millisecond,
+		MaxInterval: 20 * time.Millisecond,
+	}
+	if err := client.WaitForServerReady(context.Background(), "server-1", opts); err != nil {
+		t.Fatalf("WaitForServerReady returned an error %v", err)
+	}
+	if assert.Equal(t, 4, len(probes)) {
+		assert.True(t, probes[1].Sub(probes[0]) >= 10*time.Millisecond)
+		assert.True(t, probes[2].Sub(probes[1]) >= 20*time.Millisecond)
+		assert.True(t, probes[3].Sub(probes[2]) >= 20*time.Millisecond)
+	}
+
+	//A stopped server isn't probed
+	probes = nil
+	api.setPower("server-1", false)
+	opts.Timeout = 50 * time.Millisecond
+	assert.Error(t, client.WaitForServerReady(context.Background(), "server-1", opts))
+	assert.Empty(t, probes)
+}
+
+func TestClient_StartServerWithReadiness(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+
+	probed := 0
+	err := client.StartServerWithReadiness(context.Background(), "server-1", ReadinessOptions{
+		Probe: func(ctx context.Context, server Server) error {
+			probed++
+			return nil
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, probed)
+	assert.Equal(t, []string{"PATCH /objects/servers/server-1/power"}, api.recorded())
+
+	api.fail(http.MethodPatch, "/objects/servers/server-2/power", http.StatusInternalServerError)
+	err = client.StartServerWithReadiness(context.Background(), "server-2", ReadinessOptions{})
+	assert.Error(t, err)
+}
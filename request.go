@@ -2,8 +2,10 @@ package gsclient
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"path"
@@ -55,40 +57,37 @@ func (r *Request) execute(c Client, output interface{}) error {
 	c.cfg.logger.Debugf("%v request sent to URL: %v", r.method, url)
 
 	//Convert the body of the request to json
-	jsonBody := new(bytes.Buffer)
+	var jsonBody []byte
 	if r.body != nil {
-		err := json.NewEncoder(jsonBody).Encode(r.body)
+		var err error
+		jsonBody, err = json.Marshal(r.body)
 		if err != nil {
 			return err
 		}
 	}
+	//Secrets like passwords never end up in the log
+	c.cfg.logger.Debugf("Request body: %s", redactJSON(jsonBody))
 
-	//Add authentication headers and content type
-	request, err := http.NewRequest(r.method, url, jsonBody)
-	if err != nil {
-		return err
+	if c.cfg.DryRun && isMutating(r.method) {
+		c.cfg.logger.Infof("Dry run: %v %v %s", r.method, r.uri, redactJSON(jsonBody))
+		json.Unmarshal(c.cfg.dryRun.record(r.method, r.uri, jsonBody), output)
+		return nil
 	}
-	request.Header.Add("X-Auth-UserID", c.cfg.UserUUID)
-	request.Header.Add("X-Auth-Token", c.cfg.APIToken)
-	request.Header.Add("Content-Type", "application/json")
-	c.cfg.logger.Debugf("Request body: %v", request.Body)
 
-	//execute the request
-	result, err := c.cfg.HTTPClient.Do(request)
-	if err != nil {
-		return err
+	start := time.Now()
+	statusCode, iostream, err := r.sendAuthorized(c, url, jsonBody)
+	if c.cfg.AuditSink != nil && isMutating(r.method) {
+		c.cfg.audit(r.method, r.uri, jsonBody, start, statusCode, iostream, err)
 	}
-
-	iostream, err := ioutil.ReadAll(result.Body)
 	if err != nil {
 		return err
 	}
 
-	c.cfg.logger.Debugf("Status code returned: %v", result.StatusCode)
+	c.cfg.logger.Debugf("Status code returned: %v", statusCode)
 
-	if result.StatusCode >= 300 {
+	if statusCode >= 300 {
 		var errorMessage RequestError //error messages have a different structure, so they are read with a different struct
-		errorMessage.StatusCode = result.StatusCode
+		errorMessage.StatusCode = statusCode
 		json.Unmarshal(iostream, &errorMessage)
 		c.cfg.logger.Errorf("Error message: %v. Status: %v. Code: %v.", errorMessage.ErrorMessage, errorMessage.StatusMessage, errorMessage.StatusCode)
 		return errorMessage
@@ -98,8 +97,98 @@ func (r *Request) execute(c Client, output interface{}) error {
 	return nil
 }
 
+//sendAuthorized sends the request. If the credentials are rejected and a credentials provider is set,
+//the credentials are refreshed and the request is sent once more.
+func (r *Request) sendAuthorized(c Client, url string, jsonBody []byte) (int, []byte, error) {
+	statusCode, body, err := r.openAuthorized(c, url, jsonBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer body.Close()
+	iostream, err := ioutil.ReadAll(body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return statusCode, iostream, nil
+}
+
+//openAuthorized is sendAuthorized returning the response body unread, the caller has to close it
+func (r *Request) openAuthorized(c Client, url string, jsonBody []byte) (int, io.ReadCloser, error) {
+	statusCode, body, err := r.open(c, url, jsonBody)
+	if err != nil || statusCode != http.StatusUnauthorized || c.cfg.Credentials == nil {
+		return statusCode, body, err
+	}
+
+	//The credentials might have been rotated, so refresh them and try once more
+	c.cfg.logger.Debug("Request was not authorized, refreshing credentials")
+	if err := c.cfg.Credentials.Refresh(); err != nil {
+		c.cfg.logger.Errorf("Refreshing credentials has failed: %v", err)
+		return statusCode, body, nil
+	}
+	body.Close()
+	return r.open(c, url, jsonBody)
+}
+
+//open sends the request once with the current credentials and returns the status code and the decompressed
+//response body, the caller has to close it
+func (r *Request) open(c Client, url string, jsonBody []byte) (int, io.ReadCloser, error) {
+	credentials, err := c.cfg.credentials()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	c.cfg.rateLimiter.wait(c.cfg.RequestsPerSecond)
+
+	//Add authentication headers and content type
+	request, err := http.NewRequest(r.method, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		return 0, nil, err
+	}
+	request.Header.Add("X-Auth-UserID", credentials.UserUUID)
+	request.Header.Add("X-Auth-Token", credentials.APIToken)
+	request.Header.Add("Content-Type", "application/json")
+	if c.cfg.UserAgent != "" {
+		request.Header.Set("User-Agent", c.cfg.UserAgent)
+	}
+	if !c.cfg.DisableCompression {
+		request.Header.Set("Accept-Encoding", "gzip")
+	}
+
+	//execute the request
+	result, err := c.cfg.HTTPClient.Do(request)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	//Setting Accept-Encoding ourselves means the transport doesn't decompress the body for us
+	if result.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(result.Body)
+		if err != nil {
+			result.Body.Close()
+			return 0, nil, err
+		}
+		return result.StatusCode, gzipBody{Reader: gzipReader, body: result.Body}, nil
+	}
+	return result.StatusCode, result.Body, nil
+}
+
+//gzipBody a gzip compressed response body
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+//Close closes the gzip reader and the response body
+func (b gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
+
 //WaitForRequestCompletion allows to wait for a request to complete. Timeouts are currently hardcoded
 func (c *Client) WaitForRequestCompletion(id string) error {
+	if c.cfg.DryRun {
+		return nil
+	}
 	r := Request{
 		uri:    path.Join("/requests/", id),
 		method: "GET",
@@ -124,9 +213,20 @@ func (c *Client) WaitForRequestCompletion(id string) error {
 	}
 }
 
+//powerStatusTimeout is the time a server may take to change its power status
+const powerStatusTimeout = 2 * time.Minute
+
 //WaitForServerPowerStatus  allows to wait for a server changing its power status. Timeouts are currently hardcoded
 func (c *Client) WaitForServerPowerStatus(id string, status bool) error {
-	timer := time.After(2 * time.Minute)
+	return c.waitForServerPowerStatus(id, status, powerStatusTimeout)
+}
+
+//waitForServerPowerStatus waits for a server changing its power status until the timeout is reached
+func (c *Client) waitForServerPowerStatus(id string, status bool, timeout time.Duration) error {
+	if c.cfg.DryRun {
+		return nil
+	}
+	timer := time.After(timeout)
 	for {
 		select {
 		case <-timer:
@@ -0,0 +1,87 @@
+package gsclient
+
+import (
+	"fmt"
+)
+
+//ResizeOptions the new size of a server, zero keeps the current value
+type ResizeOptions struct {
+	Cores  int
+	Memory int
+	//HotPlug the server supports adding cores and memory while it's running, increases are applied
+	//without a restart then. Decreases always need a restart.
+	HotPlug bool
+	//Shutdown policy of shutting down a running server for the resize
+	Shutdown ShutdownPolicy
+}
+
+//ResizeResult what a resize has done
+type ResizeResult struct {
+	PreviousCores  int
+	PreviousMemory int
+	Cores          int
+	Memory         int
+	//Changed the size has been updated, it's false if the server already had the requested size
+	Changed bool
+	//WasRunning the server was running before the resize
+	WasRunning bool
+	//Restarted the server has been shut down for the resize and started again
+	Restarted bool
+}
+
+//ResizeServer changes the cores and memory of a server. A running server is shut down for the resize according to
+//the shutdown policy unless the change can be hot-plugged. By default the server is powered off if the graceful
+//shutdown fails. Afterwards the previous power state is restored, also if the update fails.
+func (c *Client) ResizeServer(id string, opts ResizeOptions) (ResizeResult, error) {
+	server, err := c.GetServer(id)
+	if err != nil {
+		return ResizeResult{}, err
+	}
+	properties := server.Properties
+	result := ResizeResult{
+		PreviousCores:  properties.Cores,
+		PreviousMemory: properties.Memory,
+		Cores:          properties.Cores,
+		Memory:         properties.Memory,
+		WasRunning:     properties.Power,
+	}
+	if opts.Cores > 0 {
+		result.Cores = opts.Cores
+	}
+	if opts.Memory > 0 {
+		result.Memory = opts.Memory
+	}
+	if result.Cores == result.PreviousCores && result.Memory == result.PreviousMemory {
+		return result, nil
+	}
+
+	decrease := result.Cores < result.PreviousCores || result.Memory < result.PreviousMemory
+	if properties.Power && (decrease || !opts.HotPlug) {
+		c.cfg.logger.Infof("Shutting down server %v for resizing", id)
+		if err := c.ShutdownServerWithPolicy(id, opts.Shutdown); err != nil {
+			return result, fmt.Errorf("shutting down server %v for resizing failed: %v", id, err)
+		}
+		result.Restarted = true
+	}
+
+	err = c.UpdateServer(id, ServerUpdateRequest{
+		Cores:  result.Cores,
+		Memory: result.Memory,
+	})
+	if err != nil {
+		err = fmt.Errorf("resizing server %v failed: %v", id, err)
+		result.Cores = result.PreviousCores
+		result.Memory = result.PreviousMemory
+	} else {
+		result.Changed = true
+	}
+	if result.Restarted {
+		if startErr := c.StartServer(id); startErr != nil {
+			if err != nil {
+				return result, fmt.Errorf("%v, starting it again failed: %v", err, startErr)
+			}
+			return result, fmt.Errorf("starting server %v after resizing failed: %v", id, startErr)
+		}
+	}
+	return result, err
+}
@@ -0,0 +1,87 @@
+package gsclient
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ResizeServer(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, "/objects/servers/server-1", `{"server": {"object_uuid": "server-1", "cores": 2, "memory": 4}}`)
+
+	testCases := []struct {
+		power    bool
+		opts     ResizeOptions
+		expected ResizeResult
+		calls    []string
+	}{
+		{
+			power:    true,
+			opts:     ResizeOptions{Cores: 4},
+			expected: ResizeResult{PreviousCores: 2, PreviousMemory: 4, Cores: 4, Memory: 4, Changed: true, WasRunning: true, Restarted: true},
+			calls: []string{
+				"PATCH /objects/servers/server-1/shutdown",
+				"PATCH /objects/servers/server-1",
+				"PATCH /objects/servers/server-1/power",
+			},
+		},
+		{
+			power:    true,
+			opts:     ResizeOptions{Memory: 8, HotPlug: true},
+			expected: ResizeResult{PreviousCores: 2, PreviousMemory: 4, Cores: 2, Memory: 8, Changed: true, WasRunning: true},
+			calls:    []string{"PATCH /objects/servers/server-1"},
+		},
+		{
+			power:    true,
+			opts:     ResizeOptions{Cores: 4, Memory: 2, HotPlug: true},
+			expected: ResizeResult{PreviousCores: 2, PreviousMemory: 4, Cores: 4, Memory: 2, Changed: true, WasRunning: true, Restarted: true},
+			calls: []string{
+				"PATCH /objects/servers/server-1/shutdown",
+				"PATCH /objects/servers/server-1",
+				"PATCH /objects/servers/server-1/power",
+			},
+		},
+		{
+			opts:     ResizeOptions{Cores: 1},
+			expected: ResizeResult{PreviousCores: 2, PreviousMemory: 4, Cores: 1, Memory: 4, Changed: true},
+			calls:    []string{"PATCH /objects/servers/server-1"},
+		},
+		{
+			power:    true,
+			opts:     ResizeOptions{Cores: 2, Memory: 4},
+			expected: ResizeResult{PreviousCores: 2, PreviousMemory: 4, Cores: 2, Memory: 4, WasRunning: true},
+			calls:    []string{},
+		},
+	}
+	for i, test := range testCases {
+		api.setPower("server-1", test.power)
+		before := len(api.recorded())
+		result, err := client.ResizeServer("server-1", test.opts)
+		if err != nil {
+			t.Fatalf("ResizeServer returned an error %v", err)
+		}
+		assert.Equal(t, test.expected, result, "test case %d", i)
+		assert.Equal(t, test.calls, api.recorded()[before:], "test case %d", i)
+	}
+}
+
+func TestClient_ResizeServerFailure(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, "/objects/servers/server-1", `{"server": {"object_uuid": "server-1", "cores": 2, "memory": 4}}`)
+	api.fail(http.MethodPatch, "/objects/servers/server-1", http.StatusBadRequest)
+	api.setPower("server-1", true)
+
+	result, err := client.ResizeServer("server-1", ResizeOptions{Cores: 64})
+	assert.Error(t, err)
+	assert.Equal(t, ResizeResult{PreviousCores: 2, PreviousMemory: 4, Cores: 2, Memory: 4, WasRunning: true, Restarted: true}, result)
+	//The server has been started again
+	assert.Equal(t, "PATCH /objects/servers/server-1/power", api.recorded()[2])
+	on, _ := client.IsServerOn("server-1")
+	assert.True(t, on)
+}
@@ -0,0 +1,182 @@
+package gsclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+//DefaultHealthTimeout is the time a restarted backend may take to become healthy if
+//RollingRestartOptions.HealthTimeout isn't set
+const DefaultHealthTimeout = 5 * time.Minute
+
+//DefaultHealthInterval is the time between two health checks if RollingRestartOptions.HealthInterval isn't set
+const DefaultHealthInterval = 5 * time.Second
+
+//HealthCheck checks whether a restarted backend server is healthy, it returns nil if it is
+type HealthCheck func(ctx context.Context, server Server, backend BackendServer) error
+
+//DrainMode how a backend is taken out of a load balancer
+type DrainMode int
+
+//Modes of draining a backend
+const (
+	//DrainWeight sets the weight of the backend to zero
+	DrainWeight DrainMode = iota
+	//DrainRemove removes the backend from the load balancer
+	DrainRemove
+)
+
+//RollingRestartOptions options of a rolling restart
+type RollingRestartOptions struct {
+	Drain DrainMode
+	//DrainDelay time to wait after draining a backend before its server is shut down
+	DrainDelay time.Duration
+	//Shutdown policy of shutting down the servers
+	Shutdown ShutdownPolicy
+	//HealthCheck is called after a server has been started until it succeeds, a server is healthy once
+	//it's running if nil
+	HealthCheck HealthCheck
+	//HealthTimeout time a server may take to become healthy, DefaultHealthTimeout if zero
+	HealthTimeout time.Duration
+	//HealthInterval time between two health checks, DefaultHealthInterval if zero
+	HealthInterval time.Duration
+}
+
+//BackendRestart the restart of a backend of a load balancer
+type BackendRestart struct {
+	Backend    BackendServer
+	ServerUUID string
+	//Done the server has been restarted and the backend restored
+	Done bool
+}
+
+//RollingRestartLoadBalancer restarts the servers behind a load balancer one at a time. For every backend server
+//the backend is drained, the server with a public IP address or name matching the host of the backend is
+//rebooted, and the backend is restored once the health check succeeds.
+//
+//All backends must match a server, otherwise nothing is restarted. If a step fails or ctx is cancelled,
+//the restart is aborted and the backend being restarted is left drained, so no traffic is sent to a server
+//which may be broken. The returned list holds all backends in the order of the load balancer.
+func (c *Client) RollingRestartLoadBalancer(ctx context.Context, id string, opts RollingRestartOptions) ([]BackendRestart, error) {
+	loadBalancer, err := c.GetLoadBalancer(id)
+	if err != nil {
+		return nil, err
+	}
+	backends := loadBalancer.Properties.BackendServers
+	servers, err := c.GetServerList()
+	if err != nil {
+		return nil, err
+	}
+	hosts := make(map[string]Server)
+	for _, server := range servers {
+		hosts[server.Properties.Name] = server
+		for _, ip := range server.Properties.Relations.PublicIPs {
+			hosts[ip.IP] = server
+		}
+	}
+	restarts := make([]BackendRestart, len(backends))
+	for i, backend := range backends {
+		server, ok := hosts[backend.Host]
+		if !ok {
+			return nil, fmt.Errorf("no server found for backend %v of load balancer %v", backend.Host, id)
+		}
+		restarts[i] = BackendRestart{Backend: backend, ServerUUID: server.Properties.ObjectUUID}
+	}
+
+	for i := range restarts {
+		restart := &restarts[i]
+		host := restart.Backend.Host
+		drained := make([]BackendServer, 0, len(backends))
+		for j, backend := range backends {
+			switch {
+			case j != i:
+				drained = append(drained, backend)
+			case opts.Drain == DrainWeight:
+				drained = append(drained, BackendServer{Host: backend.Host})
+			}
+		}
+		c.cfg.logger.Infof("Draining backend %v of load balancer %v", host, id)
+		if err := c.updateLoadBalancerBackends(id, drained); err != nil {
+			return restarts, fmt.Errorf("draining backend %v failed: %v", host, err)
+		}
+		if err := sleepContext(ctx, opts.DrainDelay); err != nil {
+			return restarts, err
+		}
+		if err := c.RebootServer(restart.ServerUUID, opts.Shutdown); err != nil {
+			return restarts, fmt.Errorf("restarting the server of backend %v failed: %v", host, err)
+		}
+		if err := c.waitForHealth(ctx, restart.ServerUUID, restart.Backend, opts); err != nil {
+			return restarts, fmt.Errorf("backend %v hasn't become healthy: %v", host, err)
+		}
+		if err := c.updateLoadBalancerBackends(id, backends); err != nil {
+			return restarts, fmt.Errorf("restoring backend %v failed: %v", host, err)
+		}
+		restart.Done = true
+	}
+	return restarts, nil
+}
+
+//updateLoadBalancerBackends replaces the backend servers of a load balancer, all other fields are kept
+func (c *Client) updateLoadBalancerBackends(id string, backends []BackendServer) error {
+	loadBalancer, err := c.GetLoadBalancer(id)
+	if err != nil {
+		return err
+	}
+	return c.UpdateLoadBalancer(id, LoadBalancerUpdateRequest{
+		Name:                loadBalancer.Properties.Name,
+		ListenIPv6UUID:      loadBalancer.Properties.ListenIPv6UUID,
+		ListenIPv4UUID:      loadBalancer.Properties.ListenIPv4UUID,
+		Algorithm:           loadBalancer.Properties.Algorithm,
+		ForwardingRules:     loadBalancer.Properties.ForwardingRules,
+		BackendServers:      backends,
+		Labels:              loadBalancer.Properties.Labels,
+		LocationUUID:        loadBalancer.Properties.LocationUUID,
+		RedirectHTTPToHTTPS: loadBalancer.Properties.RedirectHTTPToHTTPS,
+	})
+}
+
+//waitForHealth runs the health check until it succeeds or the timeout is reached
+func (c *Client) waitForHealth(ctx context.Context, id string, backend BackendServer, opts RollingRestartOptions) error {
+	if opts.HealthCheck == nil {
+		return nil
+	}
+	timeout := opts.HealthTimeout
+	if timeout <= 0 {
+		timeout = DefaultHealthTimeout
+	}
+	interval := opts.HealthInterval
+	if interval <= 0 {
+		interval = DefaultHealthInterval
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		server, err := c.GetServer(id)
+		if err == nil {
+			err = opts.HealthCheck(ctx, server, backend)
+		}
+		if err == nil {
+			return nil
+		}
+		c.cfg.logger.Debugf("Health check of backend %v has failed: %v", backend.Host, err)
+		if sleepErr := sleepContext(ctx, interval); sleepErr != nil {
+			return fmt.Errorf("%v, last check: %v", sleepErr, err)
+		}
+	}
+}
+
+//sleepContext waits for the duration, it returns early with the error of ctx if ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,114 @@
+package gsclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//prepareRollingRestartAPI serves a load balancer with two backends, one is matched by IP and one by name
+func prepareRollingRestartAPI(mux *http.ServeMux) *fakeAPI {
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, "/objects/loadbalancers/lb-1", `{"loadbalancer": {"object_uuid": "lb-1", "name": "web",
+		"algorithm": "leastconn", "labels": ["env=prod"],
+		"backend_servers": [{"host": "10.0.0.1", "weight": 50}, {"host": "web-2", "weight": 100}]}}`)
+	api.respond(http.MethodGet, apiServerBase, `{"servers": {
+		"server-1": {"object_uuid": "server-1", "name": "web-1", "relations": {"public_ips": [{"ip": "10.0.0.1"}]}},
+		"server-2": {"object_uuid": "server-2", "name": "web-2"},
+		"server-3": {"object_uuid": "server-3", "name": "db"}
+	}}`)
+	api.setPower("server-1", true)
+	api.setPower("server-2", true)
+	return api
+}
+
+func TestClient_RollingRestartLoadBalancer(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := prepareRollingRestartAPI(mux)
+
+	var checked []string
+	restarts, err := client.RollingRestartLoadBalancer(context.Background(), "lb-1", RollingRestartOptions{
+		Shutdown: ShutdownPolicy{Mode: ShutdownForce},
+		HealthCheck: func(ctx context.Context, server Server, backend BackendServer) error {
+			checked = append(checked, backend.Host)
+			if !server.Properties.Power {
+				return fmt.Errorf("server is off")
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("RollingRestartLoadBalancer returned an error %v", err)
+	}
+	assert.Equal(t, []BackendRestart{
+		{Backend: BackendServer{Host: "10.0.0.1", Weight: 50}, ServerUUID: "server-1", Done: true},
+		{Backend: BackendServer{Host: "web-2", Weight: 100}, ServerUUID: "server-2", Done: true},
+	}, restarts)
+	assert.Equal(t, []string{"10.0.0.1", "web-2"}, checked)
+	assert.Equal(t, []string{
+		"PATCH /objects/loadbalancers/lb-1",
+		"PATCH /objects/servers/server-1/power",
+		"PATCH /objects/servers/server-1/power",
+		"PATCH /objects/loadbalancers/lb-1",
+		"PATCH /objects/loadbalancers/lb-1",
+		"PATCH /objects/servers/server-2/power",
+		"PATCH /objects/servers/server-2/power",
+		"PATCH /objects/loadbalancers/lb-1",
+	}, api.recorded())
+	bodies := api.recordedBodies()
+	assert.Contains(t, bodies[0], `"backend_servers":[{"weight":0,"host":"10.0.0.1"},{"weight":100,"host":"web-2"}]`)
+	assert.Contains(t, bodies[0], `"labels":["env=prod"]`)
+	assert.Contains(t, bodies[3], `"backend_servers":[{"weight":50,"host":"10.0.0.1"},{"weight":100,"host":"web-2"}]`)
+	assert.Contains(t, bodies[4], `"backend_servers":[{"weight":50,"host":"10.0.0.1"},{"weight":0,"host":"web-2"}]`)
+}
+
+func TestClient_RollingRestartLoadBalancerAbort(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := prepareRollingRestartAPI(mux)
+
+	restarts, err := client.RollingRestartLoadBalancer(context.Background(), "lb-1", RollingRestartOptions{
+		Drain:          DrainRemove,
+		Shutdown:       ShutdownPolicy{Mode: ShutdownForce},
+		HealthTimeout:  50 * time.Millisecond,
+		HealthInterval: 10 * time.Millisecond,
+		HealthCheck: func(ctx context.Context, server Server, backend BackendServer) error {
+			return fmt.Errorf("connection refused")
+		},
+	})
+	assert.Error(t, err)
+	assert.False(t, restarts[0].Done)
+	assert.False(t, restarts[1].Done)
+	//The backend stays removed
+	assert.Equal(t, []string{
+		"PATCH /objects/loadbalancers/lb-1",
+		"PATCH /objects/servers/server-1/power",
+		"PATCH /objects/servers/server-1/power",
+	}, api.recorded())
+	assert.Contains(t, api.recordedBodies()[0], `"backend_servers":[{"weight":100,"host":"web-2"}]`)
+}
+
+func TestClient_RollingRestartLoadBalancerUnknownBackend(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := prepareRollingRestartAPI(mux)
+	api.respond(http.MethodGet, "/objects/loadbalancers/lb-1", `{"loadbalancer": {"object_uuid": "lb-1",
+		"backend_servers": [{"host": "10.0.0.1"}, {"host": "10.0.0.9"}]}}`)
+
+	_, err := client.RollingRestartLoadBalancer(context.Background(), "lb-1", RollingRestartOptions{})
+	assert.Error(t, err)
+	assert.Equal(t, 0, len(api.recorded()))
+}
+
+func TestSleepContext(t *testing.T) {
+	assert.Nil(t, sleepContext(context.Background(), time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, sleepContext(ctx, time.Hour))
+	assert.Equal(t, context.Canceled, sleepContext(ctx, 0))
+}
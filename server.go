@@ -3,6 +3,7 @@ package gsclient
 import (
 	"net/http"
 	"path"
+	"time"
 )
 
 //ServerList JSON struct of a list of servers
@@ -34,6 +35,8 @@ type ServerProperties struct {
 	UsageInMinutesCores  int             `json:"usage_in_minutes_cores"`
 	Labels               []string        `json:"labels"`
 	Relations            ServerRelations `json:"relations"`
+	CreateTime           string          `json:"create_time"`
+	ChangeTime           string          `json:"change_time"`
 }
 
 //ServerRelations JSON struct of a list of server relations
@@ -113,28 +116,14 @@ type ServerUpdateRequest struct {
 	AutoRecovery    bool     `json:"auto_recovery,omitempty"`
 }
 
-//ServerEventList JSON struct of a list of a server's events
-type ServerEventList struct {
-	List []ServerEventProperties `json:"events"`
-}
+//ServerEventList the list of events of a server, it's the same type as EventList
+type ServerEventList = EventList
 
-//ServerEvent JSON struct of a single event of a server
-type ServerEvent struct {
-	Properties ServerEventProperties `json:"event"`
-}
+//ServerEvent a single event of a server, it's the same type as Event
+type ServerEvent = Event
 
-//ServerEventProperties JSON struct of properties of a server
-type ServerEventProperties struct {
-	ObjectType    string `json:"object_type"`
-	RequestUUID   string `json:"request_uuid"`
-	ObjectUUID    string `json:"object_uuid"`
-	Activity      string `json:"activity"`
-	RequestType   string `json:"request_type"`
-	RequestStatus string `json:"request_status"`
-	Change        string `json:"change"`
-	Timestamp     string `json:"timestamp"`
-	UserUUID      string `json:"user_uuid"`
-}
+//ServerEventProperties the properties of an event of a server, it's the same type as EventProperties
+type ServerEventProperties = EventProperties
 
 //ServerMetricList JSON struct of a list of a server's metrics
 type ServerMetricList struct {
@@ -186,9 +175,69 @@ func (c *Client) GetServerList() ([]Server, error) {
 			Properties: properties,
 		})
 	}
+	sortList(servers, SortByName, func(i int) listEntry {
+		return servers[i].listEntry()
+	})
 	return servers, err
 }
 
+//GetServerListWithOptions gets a list of available servers matching the given options
+func (c *Client) GetServerListWithOptions(opts ListOptions) ([]Server, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	servers, err := c.GetServerList()
+	var filtered []Server
+	for _, server := range servers {
+		if match(server.listEntry()) {
+			filtered = append(filtered, server)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetServerMap gets the servers keyed by their UUID
+func (c *Client) GetServerMap() (map[string]Server, error) {
+	servers, err := c.GetServerList()
+	serverMap := make(map[string]Server, len(servers))
+	for _, server := range servers {
+		serverMap[server.Properties.ObjectUUID] = server
+	}
+	return serverMap, err
+}
+
+//GetServerByName gets a server by its name. If no server has the name an ObjectNotFoundError is returned,
+//if several have it an AmbiguousNameError.
+func (c *Client) GetServerByName(name string) (Server, error) {
+	servers, err := c.GetServerList()
+	if err != nil {
+		return Server{}, err
+	}
+	i, err := findByName("server", name, len(servers), func(i int) listEntry {
+		return servers[i].listEntry()
+	})
+	if err != nil {
+		return Server{}, err
+	}
+	return servers[i], nil
+}
+
+//listEntry returns the attributes list options are applied to
+func (s Server) listEntry() listEntry {
+	return listEntry{
+		uuid:         s.Properties.ObjectUUID,
+		name:         s.Properties.Name,
+		createTime:   s.Properties.CreateTime,
+		locationUUID: s.Properties.LocationUUID,
+		status:       s.Properties.Status,
+		labels:       s.Properties.Labels,
+	}
+}
+
 //CreateServer create a server
 func (c *Client) CreateServer(body ServerCreateRequest) (ServerCreateResponse, error) {
 	r := Request{
@@ -224,6 +273,28 @@ func (c *Client) UpdateServer(id string, body ServerUpdateRequest) error {
 	return r.execute(*c, nil)
 }
 
+//AddServerLabels adds labels to a server. Labels with a key the server already has replace the existing ones
+func (c *Client) AddServerLabels(id string, labels ...string) error {
+	server, err := c.GetServer(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiServerBase, id), server.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdateServer(id, ServerUpdateRequest{Labels: labels})
+	})
+}
+
+//RemoveServerLabels removes the labels with the given keys from a server
+func (c *Client) RemoveServerLabels(id string, keys ...string) error {
+	server, err := c.GetServer(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiServerBase, id), server.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdateServer(id, ServerUpdateRequest{Labels: labels})
+	})
+}
+
 //GetServerEventList gets a list of a specific server's events
 func (c *Client) GetServerEventList(id string) ([]ServerEvent, error) {
 	r := Request{
@@ -297,8 +368,39 @@ func (c *Client) StopServer(id string) error {
 	return c.setServerPowerState(id, false)
 }
 
-//ShutdownServer shutdowns a specific server
+//ShutdownServer shutdowns a specific server. If the graceful shutdown fails or times out, the server is powered off,
+//see ShutdownServerWithPolicy for other policies.
 func (c *Client) ShutdownServer(id string) error {
+	return c.ShutdownServerWithPolicy(id, ShutdownPolicy{})
+}
+
+//ShutdownMode how a server is shut down
+type ShutdownMode int
+
+//Modes of shutting down a server
+const (
+	//ShutdownGracefulThenForce shuts the server down gracefully and powers it off if that fails or times out
+	ShutdownGracefulThenForce ShutdownMode = iota
+	//ShutdownGracefulOnly shuts the server down gracefully and never powers it off, a failed or timed out
+	//shutdown is returned as error
+	ShutdownGracefulOnly
+	//ShutdownForce powers the server off right away
+	ShutdownForce
+)
+
+//DefaultShutdownTimeout is the time a graceful shutdown may take if ShutdownPolicy.Timeout isn't set
+const DefaultShutdownTimeout = 2 * time.Minute
+
+//ShutdownPolicy policy of shutting down a server. The zero value shuts down gracefully with the default timeout
+//and powers the server off if that fails.
+type ShutdownPolicy struct {
+	Mode ShutdownMode
+	//Timeout the time a graceful shutdown may take, DefaultShutdownTimeout if zero
+	Timeout time.Duration
+}
+
+//ShutdownServerWithPolicy shuts down a server according to the policy
+func (c *Client) ShutdownServerWithPolicy(id string, policy ShutdownPolicy) error {
 	//Make sure the server exists and that it isn't already in the state we need it to be
 	server, err := c.GetServer(id)
 	if err != nil {
@@ -307,6 +409,13 @@ func (c *Client) ShutdownServer(id string) error {
 	if !server.Properties.Power {
 		return nil
 	}
+	if policy.Mode == ShutdownForce {
+		return c.StopServer(id)
+	}
+	timeout := policy.Timeout
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
 	r := Request{
 		uri:    path.Join(apiServerBase, id, "shutdown"),
 		method: http.MethodPatch,
@@ -316,7 +425,7 @@ func (c *Client) ShutdownServer(id string) error {
 	err = r.execute(*c, nil)
 	if err != nil {
 		if requestError, ok := err.(RequestError); ok {
-			if requestError.StatusCode == 500 {
+			if requestError.StatusCode == 500 && policy.Mode == ShutdownGracefulThenForce {
 				c.cfg.logger.Debugf("Graceful shutdown for server %s has failed. power-off will be used", id)
 				return c.StopServer(id)
 			}
@@ -325,10 +434,23 @@ func (c *Client) ShutdownServer(id string) error {
 	}
 
 	//If we get an error, which includes a timeout, power off the server instead
-	err = c.WaitForServerPowerStatus(id, false)
-	if err != nil {
+	err = c.waitForServerPowerStatus(id, false, timeout)
+	if err != nil && policy.Mode == ShutdownGracefulThenForce {
 		c.cfg.logger.Debugf("Graceful shutdown for server %s has failed. power-off will be used", id)
 		return c.StopServer(id)
 	}
-	return nil
+	return err
+}
+
+//RebootServer shuts down a server according to the policy and starts it again. A server which is off is started.
+func (c *Client) RebootServer(id string, policy ShutdownPolicy) error {
+	if err := c.ShutdownServerWithPolicy(id, policy); err != nil {
+		return err
+	}
+	return c.StartServer(id)
+}
+
+//PowerCycleServer powers a server off and on again without shutting it down gracefully
+func (c *Client) PowerCycleServer(id string) error {
+	return c.RebootServer(id, ShutdownPolicy{Mode: ShutdownForce})
 }
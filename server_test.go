@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -26,6 +27,98 @@ func TestClient_GetServerList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockServer(true)), fmt.Sprintf("%v", res))
 }
 
+func TestClient_GetServerListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiServerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareServerListHTTPGet())
+	})
+	res, err := client.GetServerListWithOptions(ListOptions{NamePattern: "*", LabelSelector: "!deprecated"})
+	if err != nil {
+		t.Errorf("GetServerListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetServerListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetServerListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetServerListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetServerMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiServerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareServerListHTTPGet())
+	})
+	res, err := client.GetServerMap()
+	if err != nil {
+		t.Errorf("GetServerMap returned an error %v", err)
+	}
+	mock := getMockServer(true)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
+func TestClient_GetServerByName(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiServerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareServerListHTTPGet())
+	})
+	mock := getMockServer(true)
+	res, err := client.GetServerByName(mock.Properties.Name)
+	if err != nil {
+		t.Errorf("GetServerByName returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res))
+
+	_, err = client.GetServerByName("unknown")
+	assert.IsType(t, ObjectNotFoundError{}, err)
+}
+
+func TestClient_GetServerListSorted(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiServerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, `{"servers": {
+			"c": {"object_uuid": "c", "name": "web", "create_time": "2019-01-01T10:00:00Z"},
+			"b": {"object_uuid": "b", "name": "web", "create_time": "2019-02-01T10:00:00Z"},
+			"a": {"object_uuid": "a", "name": "db", "create_time": "2019-03-01T10:00:00Z"}
+		}}`)
+	})
+	uuids := func(servers []Server) []string {
+		var res []string
+		for _, server := range servers {
+			res = append(res, server.Properties.ObjectUUID)
+		}
+		return res
+	}
+	res, err := client.GetServerList()
+	if err != nil {
+		t.Errorf("GetServerList returned an error %v", err)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, uuids(res))
+
+	res, err = client.GetServerListWithOptions(ListOptions{SortBy: SortByCreateTime})
+	if err != nil {
+		t.Errorf("GetServerListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, []string{"c", "b", "a"}, uuids(res))
+}
+
 func TestClient_GetServer(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -92,6 +185,30 @@ func TestClient_UpdateServer(t *testing.T) {
 	}
 }
 
+func TestClient_AddServerLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiServerBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareServerHTTPGet(true))
+	err := client.AddServerLabels(dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddServerLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"label", "env=prod"}}, *updates)
+}
+
+func TestClient_RemoveServerLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiServerBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareServerHTTPGet(true))
+	err := client.RemoveServerLabels(dummyUUID, "label")
+	if err != nil {
+		t.Errorf("RemoveServerLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{}}, *updates)
+}
+
 func TestClient_DeleteServer(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -347,3 +464,75 @@ func prepareServerMetricListHTTPGet() string {
 	res, _ := json.Marshal(metric.Properties)
 	return fmt.Sprintf(`{"server_metrics": [%s]}`, string(res))
 }
+
+func TestClient_ShutdownServerWithPolicy(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	shutdownURI := path.Join(apiServerBase, dummyUUID, "shutdown")
+	powerURI := path.Join(apiServerBase, dummyUUID, "power")
+
+	//Forcing doesn't try a graceful shutdown
+	api.setPower(dummyUUID, true)
+	assert.Nil(t, client.ShutdownServerWithPolicy(dummyUUID, ShutdownPolicy{Mode: ShutdownForce}))
+	assert.Equal(t, []string{"PATCH " + powerURI}, api.recorded())
+
+	//A server which is off isn't touched
+	assert.Nil(t, client.ShutdownServerWithPolicy(dummyUUID, ShutdownPolicy{Mode: ShutdownForce}))
+	assert.Equal(t, 1, len(api.recorded()))
+
+	api.setPower(dummyUUID, true)
+	assert.Nil(t, client.ShutdownServerWithPolicy(dummyUUID, ShutdownPolicy{Mode: ShutdownGracefulOnly}))
+	assert.Equal(t, "PATCH "+shutdownURI, api.recorded()[1])
+	assert.Equal(t, 2, len(api.recorded()))
+
+	//A failed graceful shutdown is only forced by the default policy
+	api.setPower(dummyUUID, true)
+	api.fail(http.MethodPatch, shutdownURI, http.StatusInternalServerError)
+	assert.Error(t, client.ShutdownServerWithPolicy(dummyUUID, ShutdownPolicy{Mode: ShutdownGracefulOnly}))
+	assert.Equal(t, 3, len(api.recorded()))
+	assert.Nil(t, client.ShutdownServerWithPolicy(dummyUUID, ShutdownPolicy{}))
+	assert.Equal(t, []string{"PATCH " + shutdownURI, "PATCH " + powerURI}, api.recorded()[3:])
+}
+
+func TestClient_ShutdownServerWithPolicyTimeout(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	shutdownURI := path.Join(apiServerBase, dummyUUID, "shutdown")
+	//The server ignores the shutdown
+	api.respond(http.MethodPatch, shutdownURI, "")
+
+	api.setPower(dummyUUID, true)
+	err := client.ShutdownServerWithPolicy(dummyUUID, ShutdownPolicy{Mode: ShutdownGracefulOnly, Timeout: 100 * time.Millisecond})
+	assert.Error(t, err)
+	on, _ := client.IsServerOn(dummyUUID)
+	assert.True(t, on)
+
+	err = client.ShutdownServerWithPolicy(dummyUUID, ShutdownPolicy{Timeout: 100 * time.Millisecond})
+	assert.Nil(t, err)
+	on, _ = client.IsServerOn(dummyUUID)
+	assert.False(t, on)
+}
+
+func TestClient_RebootServer(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	shutdownURI := path.Join(apiServerBase, dummyUUID, "shutdown")
+	powerURI := path.Join(apiServerBase, dummyUUID, "power")
+
+	api.setPower(dummyUUID, true)
+	assert.Nil(t, client.RebootServer(dummyUUID, ShutdownPolicy{}))
+	assert.Equal(t, []string{"PATCH " + shutdownURI, "PATCH " + powerURI}, api.recorded())
+	on, _ := client.IsServerOn(dummyUUID)
+	assert.True(t, on)
+
+	assert.Nil(t, client.PowerCycleServer(dummyUUID))
+	assert.Equal(t, []string{"PATCH " + powerURI, "PATCH " + powerURI}, api.recorded()[2:])
+
+	//The server isn't started if the shutdown has failed
+	api.fail(http.MethodPatch, shutdownURI, http.StatusInternalServerError)
+	assert.Error(t, client.RebootServer(dummyUUID, ShutdownPolicy{Mode: ShutdownGracefulOnly}))
+	assert.Equal(t, 5, len(api.recorded()))
+}
@@ -0,0 +1,110 @@
+package gsclient
+
+//ServerDetails a server with all its related objects expanded
+type ServerDetails struct {
+	Server    Server
+	Storages  []ServerStorageDetails
+	Networks  []ServerNetworkDetails
+	IPs       []ServerIPDetails
+	ISOImages []ServerISOImageDetails
+}
+
+//ServerStorageDetails a relation between a server and a storage together with the storage
+type ServerStorageDetails struct {
+	Relation ServerStorageRelationProperties
+	Storage  Storage
+}
+
+//ServerNetworkDetails a relation between a server and a network together with the network and the firewall
+//template attached to the relation. Firewall is nil if there is no firewall template.
+type ServerNetworkDetails struct {
+	Relation ServerNetworkRelationProperties
+	Network  Network
+	Firewall *Firewall
+}
+
+//ServerIPDetails a relation between a server and an IP address together with the IP
+type ServerIPDetails struct {
+	Relation ServerIPRelationProperties
+	IP       IP
+}
+
+//ServerISOImageDetails a relation between a server and an ISO-Image together with the ISO-Image
+type ServerISOImageDetails struct {
+	Relation ServerIsoImageRelationProperties
+	ISOImage ISOImage
+}
+
+//GetServerDetails gets a server and all its related storages, networks, firewall templates, IPs and ISO-Images.
+//The related objects are fetched concurrently, see Config.MaxConcurrency. If any of them cannot be fetched,
+//the error is a BulkError keyed by the UUID of the object and the details hold everything else.
+func (c *Client) GetServerDetails(id string) (ServerDetails, error) {
+	server, err := c.GetServer(id)
+	if err != nil {
+		return ServerDetails{}, err
+	}
+	relations := server.Properties.Relations
+	details := ServerDetails{
+		Server:    server,
+		Storages:  make([]ServerStorageDetails, len(relations.Storages)),
+		Networks:  make([]ServerNetworkDetails, len(relations.Networks)),
+		IPs:       make([]ServerIPDetails, len(relations.PublicIPs)),
+		ISOImages: make([]ServerISOImageDetails, len(relations.IsoImages)),
+	}
+
+	//Every related object is fetched by a task, the UUIDs are used for reporting errors
+	var ids []string
+	var tasks []func() error
+	for i, relation := range relations.Storages {
+		i, relation := i, relation
+		details.Storages[i].Relation = relation
+		ids = append(ids, relation.ObjectUUID)
+		tasks = append(tasks, func() (err error) {
+			details.Storages[i].Storage, err = c.GetStorage(relation.ObjectUUID)
+			return err
+		})
+	}
+	for i, relation := range relations.Networks {
+		i, relation := i, relation
+		details.Networks[i].Relation = relation
+		ids = append(ids, relation.ObjectUUID)
+		tasks = append(tasks, func() (err error) {
+			details.Networks[i].Network, err = c.GetNetwork(relation.ObjectUUID)
+			return err
+		})
+		if relation.FirewallTemplateUUID != "" {
+			ids = append(ids, relation.FirewallTemplateUUID)
+			tasks = append(tasks, func() error {
+				firewall, err := c.GetFirewall(relation.FirewallTemplateUUID)
+				if err != nil {
+					return err
+				}
+				details.Networks[i].Firewall = &firewall
+				return nil
+			})
+		}
+	}
+	for i, relation := range relations.PublicIPs {
+		i, relation := i, relation
+		details.IPs[i].Relation = relation
+		ids = append(ids, relation.ObjectUUID)
+		tasks = append(tasks, func() (err error) {
+			details.IPs[i].IP, err = c.GetIP(relation.ObjectUUID)
+			return err
+		})
+	}
+	for i, relation := range relations.IsoImages {
+		i, relation := i, relation
+		details.ISOImages[i].Relation = relation
+		ids = append(ids, relation.ObjectUUID)
+		tasks = append(tasks, func() (err error) {
+			details.ISOImages[i].ISOImage, err = c.GetISOImage(relation.ObjectUUID)
+			return err
+		})
+	}
+
+	errs := c.forEachConcurrently(len(tasks), func(i int) error {
+		return tasks[i]()
+	})
+	return details, bulkError(ids, errs)
+}
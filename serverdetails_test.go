@@ -0,0 +1,76 @@
+package gsclient
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetServerDetails(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	mux.HandleFunc(path.Join(apiServerBase, dummyUUID), func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprintf(writer, `{"server": {"object_uuid": "%s", "name": "web", "relations": {
+			"storages": [{"object_uuid": "storage-1", "bootdevice": true}],
+			"networks": [
+				{"object_uuid": "network-1", "firewall_template_uuid": "firewall-1"},
+				{"object_uuid": "network-2"}
+			],
+			"public_ips": [{"object_uuid": "ip-1", "ip": "185.201.147.176"}],
+			"isoimages": [{"object_uuid": "isoimage-1"}]
+		}}}`, dummyUUID)
+	})
+	handle := func(base, key string) {
+		mux.HandleFunc(base+"/", func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, http.MethodGet, request.Method)
+			fmt.Fprintf(writer, `{"%s": {"object_uuid": "%s"}}`, key, path.Base(request.URL.Path))
+		})
+	}
+	handle(apiStorageBase, "storage")
+	handle(apiNetworkBase, "network")
+	handle(apiFirewallBase, "firewall")
+	handle(apiIPBase, "ip")
+	mux.HandleFunc(apiISOBase+"/", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+	})
+
+	details, err := client.GetServerDetails(dummyUUID)
+	assert.Equal(t, "web", details.Server.Properties.Name)
+	if assert.Equal(t, 1, len(details.Storages)) {
+		assert.True(t, details.Storages[0].Relation.BootDevice)
+		assert.Equal(t, "storage-1", details.Storages[0].Storage.Properties.ObjectUUID)
+	}
+	if assert.Equal(t, 2, len(details.Networks)) {
+		assert.Equal(t, "network-1", details.Networks[0].Network.Properties.ObjectUUID)
+		if assert.NotNil(t, details.Networks[0].Firewall) {
+			assert.Equal(t, "firewall-1", details.Networks[0].Firewall.Properties.ObjectUUID)
+		}
+		assert.Equal(t, "network-2", details.Networks[1].Network.Properties.ObjectUUID)
+		assert.Nil(t, details.Networks[1].Firewall)
+	}
+	if assert.Equal(t, 1, len(details.IPs)) {
+		assert.Equal(t, "185.201.147.176", details.IPs[0].Relation.IP)
+		assert.Equal(t, "ip-1", details.IPs[0].IP.Properties.ObjectUUID)
+	}
+	if assert.Equal(t, 1, len(details.ISOImages)) {
+		assert.Equal(t, "isoimage-1", details.ISOImages[0].Relation.ObjectUUID)
+	}
+	if bulkError, ok := err.(BulkError); assert.True(t, ok) {
+		assert.Equal(t, 1, len(bulkError))
+		assert.Error(t, bulkError["isoimage-1"])
+	}
+}
+
+func TestClient_GetServerDetailsNotFound(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	mux.HandleFunc(path.Join(apiServerBase, dummyUUID), func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+	})
+	_, err := client.GetServerDetails(dummyUUID)
+	assert.IsType(t, RequestError{}, err)
+}
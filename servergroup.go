@@ -0,0 +1,285 @@
+package gsclient
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//ServerGroupLabel is the key of the label holding the name of the group of a server
+const ServerGroupLabel = "server-group"
+
+//ServerGroupSpec specification of servers added to a group by CreateServerGroup
+type ServerGroupSpec struct {
+	//Name name of the group, the servers are named after the group with a number, e.g. "web-1"
+	Name string
+	//Replicas number of servers created, at least one
+	Replicas int
+	//Zones availability zones of the location the servers are spread across. They must be given, as
+	//locations don't carry their availability zones, see LocationProperties.
+	Zones []string
+	//Server specification of every server, its name and availability zone are set by the group
+	Server ServerSpec
+}
+
+//ServerGroupMember a server of a group
+type ServerGroupMember struct {
+	ServerUUID string
+	Name       string
+	Zone       string
+}
+
+//ServerGroup the servers with the label ServerGroupLabel set to the name of the group, sorted by name
+type ServerGroup struct {
+	Name    string
+	Members []ServerGroupMember
+}
+
+//Distribution returns the number of members in each availability zone
+func (g ServerGroup) Distribution() map[string]int {
+	distribution := make(map[string]int)
+	for _, member := range g.Members {
+		distribution[member.Zone]++
+	}
+	return distribution
+}
+
+//ZoneMigration a server moved to another availability zone to rebalance a group.
+//Done is set once the server has been moved.
+type ZoneMigration struct {
+	ServerUUID string
+	Name       string
+	From       string
+	To         string
+	Done       bool
+}
+
+//String returns the migration as text
+func (m ZoneMigration) String() string {
+	return fmt.Sprintf("move %s (%s) from zone %q to zone %q", m.ServerUUID, m.Name, m.From, m.To)
+}
+
+//RebalanceOptions options of rebalancing a server group
+type RebalanceOptions struct {
+	//Zones availability zones the members are spread across, members in other zones are moved
+	Zones []string
+	//Shutdown policy of shutting down running servers which are moved
+	Shutdown ShutdownPolicy
+	//DryRun only plans the migrations
+	DryRun bool
+}
+
+//validateServerGroupName checks that the label of a group can be selected, see ParseLabelSelector
+func validateServerGroupName(name string) error {
+	if name == "" || strings.ContainsAny(name, ",=!") || strings.TrimSpace(name) != name {
+		return fmt.Errorf("invalid server group name %q, it must be neither empty nor contain \",\", \"=\", \"!\" or surrounding spaces", name)
+	}
+	return nil
+}
+
+//GetServerGroup gets the servers of a group
+func (c *Client) GetServerGroup(name string) (ServerGroup, error) {
+	if err := validateServerGroupName(name); err != nil {
+		return ServerGroup{}, err
+	}
+	servers, err := c.GetServerListWithOptions(ListOptions{LabelSelector: ServerGroupLabel + "=" + name})
+	if err != nil {
+		return ServerGroup{}, err
+	}
+	group := ServerGroup{Name: name}
+	for _, server := range servers {
+		group.Members = append(group.Members, ServerGroupMember{
+			ServerUUID: server.Properties.ObjectUUID,
+			Name:       server.Properties.Name,
+			Zone:       server.Properties.AvailablityZone,
+		})
+	}
+	return group, nil
+}
+
+//CreateServerGroup adds servers to a group, the group is created by adding its first servers. Every server
+//is provisioned with ProvisionServer in the availability zone with the fewest members of the group, so the
+//servers of the group are spread evenly across the zones. The servers get the label ServerGroupLabel.
+//
+//The servers are provisioned concurrently, see Config.MaxConcurrency. A server which fails is rolled back,
+//the others are kept. The error is a BulkError keyed by the names of the failed servers, the returned group
+//holds all servers of the group which exist.
+func (c *Client) CreateServerGroup(spec ServerGroupSpec) (ServerGroup, error) {
+	if err := validateServerGroupName(spec.Name); err != nil {
+		return ServerGroup{}, err
+	}
+	if len(spec.Zones) == 0 {
+		return ServerGroup{}, errors.New("no availability zones for the server group")
+	}
+	if spec.Replicas <= 0 {
+		return ServerGroup{}, fmt.Errorf("invalid number of replicas %d, at least one server must be added", spec.Replicas)
+	}
+	group, err := c.GetServerGroup(spec.Name)
+	if err != nil {
+		return group, err
+	}
+	distribution := group.Distribution()
+	names := make(map[string]bool, len(group.Members))
+	for _, member := range group.Members {
+		names[member.Name] = true
+	}
+	labels := []string{ServerGroupLabel + "=" + spec.Name}
+	for _, label := range spec.Server.Labels {
+		if key, _ := splitLabel(label); key != ServerGroupLabel {
+			labels = append(labels, label)
+		}
+	}
+
+	specs := make([]ServerSpec, spec.Replicas)
+	ids := make([]string, spec.Replicas)
+	number := 1
+	for i := range specs {
+		for names[spec.Name+"-"+strconv.Itoa(number)] {
+			number++
+		}
+		zone := leastLoadedZone(distribution, spec.Zones)
+		distribution[zone]++
+		specs[i] = spec.Server
+		specs[i].Name = spec.Name + "-" + strconv.Itoa(number)
+		specs[i].AvailablityZone = zone
+		specs[i].Labels = labels
+		ids[i] = specs[i].Name
+		names[specs[i].Name] = true
+	}
+
+	members := make([]ServerGroupMember, spec.Replicas)
+	errs := c.forEachConcurrently(len(specs), func(i int) error {
+		server, err := c.ProvisionServer(specs[i])
+		members[i] = ServerGroupMember{ServerUUID: server.ServerUUID, Name: specs[i].Name, Zone: specs[i].AvailablityZone}
+		return err
+	})
+	for i, member := range members {
+		if errs[i] == nil {
+			group.Members = append(group.Members, member)
+		}
+	}
+	sortList(group.Members, SortByName, func(i int) listEntry {
+		return listEntry{name: group.Members[i].Name, uuid: group.Members[i].ServerUUID}
+	})
+	return group, bulkError(ids, errs)
+}
+
+//RebalanceServerGroup moves servers of a group to other availability zones until the numbers of members
+//in the zones differ by one at most. Members in zones which aren't in opts.Zones are moved first. Servers
+//are moved one at a time with MigrateServer.
+//
+//The returned migrations are planned, with DryRun nothing is changed. If a migration fails, the rebalancing
+//stops, the migrations carried out so far are marked as done.
+func (c *Client) RebalanceServerGroup(name string, opts RebalanceOptions) ([]ZoneMigration, error) {
+	if len(opts.Zones) == 0 {
+		return nil, errors.New("no availability zones for the server group")
+	}
+	group, err := c.GetServerGroup(name)
+	if err != nil {
+		return nil, err
+	}
+	migrations := planRebalance(group.Members, opts.Zones)
+	if opts.DryRun {
+		return migrations, nil
+	}
+	for i := range migrations {
+		migration := &migrations[i]
+		c.cfg.logger.Infof("Server group %v: %v", name, migration)
+		if err := c.MigrateServer(migration.ServerUUID, migration.To, opts.Shutdown); err != nil {
+			return migrations, fmt.Errorf("%v failed: %v", migration, err)
+		}
+		migration.Done = true
+	}
+	return migrations, nil
+}
+
+//MigrateServer moves a server to another availability zone of its location. A running server is shut down
+//according to the shutdown policy and started again afterwards, also if the move fails.
+func (c *Client) MigrateServer(id, zone string, policy ShutdownPolicy) error {
+	server, err := c.GetServer(id)
+	if err != nil {
+		return err
+	}
+	if server.Properties.AvailablityZone == zone {
+		return nil
+	}
+	running := server.Properties.Power
+	if running {
+		if err := c.ShutdownServerWithPolicy(id, policy); err != nil {
+			return fmt.Errorf("shutting down server %v for the migration failed: %v", id, err)
+		}
+	}
+	err = c.UpdateServer(id, ServerUpdateRequest{AvailablityZone: zone})
+	if err != nil {
+		err = fmt.Errorf("moving server %v to zone %v failed: %v", id, zone, err)
+	}
+	if running {
+		if startErr := c.StartServer(id); startErr != nil {
+			if err != nil {
+				return fmt.Errorf("%v, starting it again failed: %v", err, startErr)
+			}
+			return fmt.Errorf("starting server %v after the migration failed: %v", id, startErr)
+		}
+	}
+	return err
+}
+
+//leastLoadedZone returns the zone with the fewest members, the first one of the zones if several have as few
+func leastLoadedZone(distribution map[string]int, zones []string) string {
+	least := zones[0]
+	for _, zone := range zones {
+		if distribution[zone] < distribution[least] {
+			least = zone
+		}
+	}
+	return least
+}
+
+//planRebalance plans the migrations spreading the members evenly across the zones. Members outside the
+//zones are moved first, then the last member by name of the zone with the most members is moved to the
+//zone with the fewest until they differ by one at most.
+func planRebalance(members []ServerGroupMember, zones []string) []ZoneMigration {
+	byZone := make(map[string][]ServerGroupMember, len(zones))
+	distribution := make(map[string]int, len(zones))
+	for _, zone := range zones {
+		byZone[zone] = nil
+	}
+	var misplaced []ServerGroupMember
+	for _, member := range members {
+		if _, ok := byZone[member.Zone]; ok {
+			byZone[member.Zone] = append(byZone[member.Zone], member)
+			distribution[member.Zone]++
+		} else {
+			misplaced = append(misplaced, member)
+		}
+	}
+
+	var migrations []ZoneMigration
+	move := func(member ServerGroupMember, to string) {
+		migrations = append(migrations, ZoneMigration{ServerUUID: member.ServerUUID, Name: member.Name, From: member.Zone, To: to})
+		member.Zone = to
+		byZone[to] = append(byZone[to], member)
+		distribution[to]++
+	}
+	for _, member := range misplaced {
+		move(member, leastLoadedZone(distribution, zones))
+	}
+	for {
+		most := zones[0]
+		for _, zone := range zones {
+			if distribution[zone] > distribution[most] {
+				most = zone
+			}
+		}
+		least := leastLoadedZone(distribution, zones)
+		if distribution[most]-distribution[least] <= 1 {
+			return migrations
+		}
+		last := len(byZone[most]) - 1
+		member := byZone[most][last]
+		byZone[most] = byZone[most][:last]
+		distribution[most]--
+		move(member, least)
+	}
+}
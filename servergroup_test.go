@@ -0,0 +1,192 @@
+package gsclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CreateServerGroup(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	client.cfg.MaxConcurrency = 1
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, apiServerBase, `{"servers": {
+		"server-1": {"object_uuid": "server-1", "name": "web-1", "availability_zone": "a", "labels": ["server-group=web"]},
+		"server-2": {"object_uuid": "server-2", "name": "db-1", "availability_zone": "b", "labels": ["server-group=db"]}}}`)
+	api.respond(http.MethodPost, apiServerBase, `{"object_uuid": "server-3", "request_uuid": "r1"}`)
+
+	group, err := client.CreateServerGroup(ServerGroupSpec{
+		Name:     "web",
+		Replicas: 3,
+		Zones:    []string{"a", "b"},
+		Server: ServerSpec{
+			Cores:     2,
+			Memory:    4,
+			Labels:    []string{"env=prod", "server-group=other"},
+			SkipStart: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateServerGroup returned an error %v", err)
+	}
+	assert.Equal(t, ServerGroup{Name: "web", Members: []ServerGroupMember{
+		{ServerUUID: "server-1", Name: "web-1", Zone: "a"},
+		{ServerUUID: "server-3", Name: "web-2", Zone: "b"},
+		{ServerUUID: "server-3", Name: "web-3", Zone: "a"},
+		{ServerUUID: "server-3", Name: "web-4", Zone: "b"},
+	}}, group)
+	assert.Equal(t, map[string]int{"a": 2, "b": 2}, group.Distribution())
+	var created []ServerCreateRequest
+	for _, body := range api.recordedBodies() {
+		var request ServerCreateRequest
+		json.Unmarshal([]byte(body), &request)
+		created = append(created, request)
+	}
+	if assert.Equal(t, 3, len(created)) {
+		for i, zone := range []string{"b", "a", "b"} {
+			assert.Equal(t, zone, created[i].AvailablityZone)
+			assert.Equal(t, []string{"server-group=web", "env=prod"}, created[i].Labels)
+		}
+	}
+
+	_, err = client.CreateServerGroup(ServerGroupSpec{Name: "web", Replicas: 1})
+	assert.Error(t, err)
+
+	//Names which can't be selected by label and too few replicas are rejected before anything is created
+	before := len(api.recorded())
+	for _, name := range []string{"", "web,db", "web=1", "!web", " web"} {
+		_, err = client.CreateServerGroup(ServerGroupSpec{Name: name, Replicas: 1, Zones: []string{"a"}})
+		assert.Error(t, err, name)
+		_, err = client.GetServerGroup(name)
+		assert.Error(t, err, name)
+	}
+	for _, replicas := range []int{0, -1} {
+		_, err = client.CreateServerGroup(ServerGroupSpec{Name: "web", Replicas: replicas, Zones: []string{"a"}})
+		assert.Error(t, err, replicas)
+	}
+	assert.Equal(t, before, len(api.recorded()))
+}
+
+func TestClient_CreateServerGroupFailure(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, apiServerBase, `{"servers": {}}`)
+	api.fail(http.MethodPost, apiServerBase, http.StatusBadRequest)
+
+	group, err := client.CreateServerGroup(ServerGroupSpec{Name: "web", Replicas: 2, Zones: []string{"a", "b"}})
+	if bulkErr, ok := err.(BulkError); assert.True(t, ok) {
+		assert.Equal(t, 2, len(bulkErr))
+		assert.Error(t, bulkErr["web-1"])
+		assert.Error(t, bulkErr["web-2"])
+	}
+	assert.Empty(t, group.Members)
+}
+
+func TestClient_RebalanceServerGroup(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, apiServerBase, `{"servers": {
+		"server-1": {"object_uuid": "server-1", "name": "web-1", "availability_zone": "a", "labels": ["server-group=web"]},
+		"server-2": {"object_uuid": "server-2", "name": "web-2", "availability_zone": "a", "labels": ["server-group=web"]},
+		"server-3": {"object_uuid": "server-3", "name": "web-3", "availability_zone": "a", "labels": ["server-group=web"]},
+		"server-4": {"object_uuid": "server-4", "name": "web-4", "availability_zone": "c", "labels": ["server-group=web"]}}}`)
+	api.setPower("server-3", true)
+
+	expected := []ZoneMigration{
+		{ServerUUID: "server-4", Name: "web-4", From: "c", To: "b"},
+		{ServerUUID: "server-3", Name: "web-3", From: "a", To: "b"},
+	}
+	opts := RebalanceOptions{Zones: []string{"a", "b"}, DryRun: true}
+	migrations, err := client.RebalanceServerGroup("web", opts)
+	if err != nil {
+		t.Fatalf("RebalanceServerGroup returned an error %v", err)
+	}
+	assert.Equal(t, expected, migrations)
+	assert.Empty(t, api.recorded())
+
+	opts.DryRun = false
+	migrations, err = client.RebalanceServerGroup("web", opts)
+	if err != nil {
+		t.Fatalf("RebalanceServerGroup returned an error %v", err)
+	}
+	for i := range expected {
+		expected[i].Done = true
+	}
+	assert.Equal(t, expected, migrations)
+	assert.Equal(t, []string{
+		"PATCH /objects/servers/server-4",
+		"PATCH /objects/servers/server-3/shutdown",
+		"PATCH /objects/servers/server-3",
+		"PATCH /objects/servers/server-3/power",
+	}, api.recorded())
+	assert.JSONEq(t, `{"availability_zone": "b"}`, api.recordedBodies()[0])
+
+	_, err = client.RebalanceServerGroup("web", RebalanceOptions{})
+	assert.Error(t, err)
+}
+
+func TestClient_MigrateServer(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	api := newFakeAPI(mux)
+	api.respond(http.MethodGet, "/objects/servers/server-1", `{"server": {"object_uuid": "server-1", "availability_zone": "a"}}`)
+	api.fail(http.MethodPatch, "/objects/servers/server-1", http.StatusBadRequest)
+	api.setPower("server-1", true)
+
+	assert.NoError(t, client.MigrateServer("server-1", "a", ShutdownPolicy{}))
+	assert.Empty(t, api.recorded())
+
+	//The server is started again although the migration has failed
+	assert.Error(t, client.MigrateServer("server-1", "b", ShutdownPolicy{}))
+	assert.Equal(t, []string{
+		"PATCH /objects/servers/server-1/shutdown",
+		"PATCH /objects/servers/server-1",
+		"PATCH /objects/servers/server-1/power",
+	}, api.recorded())
+}
+
+func TestPlanRebalance(t *testing.T) {
+	members := func(zones ...string) []ServerGroupMember {
+		var list []ServerGroupMember
+		for i, zone := range zones {
+			name := "web-" + strconv.Itoa(i+1)
+			list = append(list, ServerGroupMember{ServerUUID: name, Name: name, Zone: zone})
+		}
+		return list
+	}
+	testCases := []struct {
+		members  []ServerGroupMember
+		zones    []string
+		expected []ZoneMigration
+	}{
+		{
+			members: members("a", "b", "a"),
+			zones:   []string{"a", "b"},
+		},
+		{
+			members: members("a", "a", "a", "a", "a"),
+			zones:   []string{"a", "b", "c"},
+			expected: []ZoneMigration{
+				{ServerUUID: "web-5", Name: "web-5", From: "a", To: "b"},
+				{ServerUUID: "web-4", Name: "web-4", From: "a", To: "c"},
+				{ServerUUID: "web-3", Name: "web-3", From: "a", To: "b"},
+			},
+		},
+		{
+			members: members("", "b"),
+			zones:   []string{"a", "b"},
+			expected: []ZoneMigration{
+				{ServerUUID: "web-1", Name: "web-1", From: "", To: "a"},
+			},
+		},
+	}
+	for i, test := range testCases {
+		assert.Equal(t, test.expected, planRebalance(test.members, test.zones), "test case %d", i)
+	}
+}
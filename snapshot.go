@@ -84,9 +84,53 @@ func (c *Client) GetStorageSnapshotList(id string) ([]StorageSnapshot, error) {
 	for _, properties := range response.List {
 		snapshots = append(snapshots, StorageSnapshot{Properties: properties})
 	}
+	sortList(snapshots, SortByName, func(i int) listEntry {
+		return snapshots[i].listEntry()
+	})
 	return snapshots, err
 }
 
+//GetStorageSnapshotListWithOptions gets a list of storage snapshots matching the given options
+func (c *Client) GetStorageSnapshotListWithOptions(id string, opts ListOptions) ([]StorageSnapshot, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := c.GetStorageSnapshotList(id)
+	var filtered []StorageSnapshot
+	for _, snapshot := range snapshots {
+		if match(snapshot.listEntry()) {
+			filtered = append(filtered, snapshot)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetStorageSnapshotMap gets the storage snapshots keyed by their UUID
+func (c *Client) GetStorageSnapshotMap(id string) (map[string]StorageSnapshot, error) {
+	snapshots, err := c.GetStorageSnapshotList(id)
+	snapshotMap := make(map[string]StorageSnapshot, len(snapshots))
+	for _, snapshot := range snapshots {
+		snapshotMap[snapshot.Properties.ObjectUUID] = snapshot
+	}
+	return snapshotMap, err
+}
+
+//listEntry returns the attributes list options are applied to
+func (s StorageSnapshot) listEntry() listEntry {
+	return listEntry{
+		uuid:         s.Properties.ObjectUUID,
+		name:         s.Properties.Name,
+		createTime:   s.Properties.CreateTime,
+		locationUUID: s.Properties.LocationUUID,
+		status:       s.Properties.Status,
+		labels:       s.Properties.Labels,
+	}
+}
+
 //GetStorageSnapshot gets a specific storage's snapshot based on given storage id and snapshot id.
 func (c *Client) GetStorageSnapshot(storageID, snapshotID string) (StorageSnapshot, error) {
 	r := Request{
@@ -124,6 +168,28 @@ func (c *Client) UpdateStorageSnapshot(storageID, snapshotID string, body Storag
 	return r.execute(*c, nil)
 }
 
+//AddStorageSnapshotLabels adds labels to a storage snapshot. Labels with a key the storage snapshot already has replace the existing ones
+func (c *Client) AddStorageSnapshotLabels(storageID, snapshotID string, labels ...string) error {
+	snapshot, err := c.GetStorageSnapshot(storageID, snapshotID)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiStorageBase, storageID, "snapshots", snapshotID), snapshot.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdateStorageSnapshot(storageID, snapshotID, StorageSnapshotUpdateRequest{Labels: labels})
+	})
+}
+
+//RemoveStorageSnapshotLabels removes the labels with the given keys from a storage snapshot
+func (c *Client) RemoveStorageSnapshotLabels(storageID, snapshotID string, keys ...string) error {
+	snapshot, err := c.GetStorageSnapshot(storageID, snapshotID)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiStorageBase, storageID, "snapshots", snapshotID), snapshot.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdateStorageSnapshot(storageID, snapshotID, StorageSnapshotUpdateRequest{Labels: labels})
+	})
+}
+
 //DeleteStorageSnapshot deletes a specific storage's snapshot
 func (c *Client) DeleteStorageSnapshot(storageID, snapshotID string) error {
 	r := Request{
@@ -26,6 +26,47 @@ func TestClient_GetStorageSnapshotList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockStorageSnapshot()), fmt.Sprintf("%v", res))
 }
 
+func TestClient_GetStorageSnapshotListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID, "snapshots")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareStorageSnapshotListHTTPGet())
+	})
+	res, err := client.GetStorageSnapshotListWithOptions(dummyUUID, ListOptions{NamePattern: "*", LabelSelector: "!deprecated", SortBy: SortByCreateTime})
+	if err != nil {
+		t.Errorf("GetStorageSnapshotListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetStorageSnapshotListWithOptions(dummyUUID, ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetStorageSnapshotListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetStorageSnapshotListWithOptions(dummyUUID, ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetStorageSnapshotMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID, "snapshots")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareStorageSnapshotListHTTPGet())
+	})
+	res, err := client.GetStorageSnapshotMap(dummyUUID)
+	if err != nil {
+		t.Errorf("GetStorageSnapshotMap returned an error %v", err)
+	}
+	mock := getMockStorageSnapshot()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
 func TestClient_GetStorageSnapshot(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -83,6 +124,30 @@ func TestClient_UpdateStorageSnapshot(t *testing.T) {
 	}
 }
 
+func TestClient_AddStorageSnapshotLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID, "snapshots", dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareStorageSnapshotHTTPGet())
+	err := client.AddStorageSnapshotLabels(dummyUUID, dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddStorageSnapshotLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"label", "env=prod"}}, *updates)
+}
+
+func TestClient_RemoveStorageSnapshotLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID, "snapshots", dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareStorageSnapshotHTTPGet())
+	err := client.RemoveStorageSnapshotLabels(dummyUUID, dummyUUID, "label")
+	if err != nil {
+		t.Errorf("RemoveStorageSnapshotLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{}}, *updates)
+}
+
 func TestClient_DeleteStorageSnapshot(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
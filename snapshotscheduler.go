@@ -78,9 +78,52 @@ func (c *Client) GetStorageSnapshotScheduleList(id string) ([]StorageSnapshotSch
 	for _, properties := range response.List {
 		schedules = append(schedules, StorageSnapshotSchedule{Properties: properties})
 	}
+	sortList(schedules, SortByName, func(i int) listEntry {
+		return schedules[i].listEntry()
+	})
 	return schedules, err
 }
 
+//GetStorageSnapshotScheduleListWithOptions gets a list of storage snapshot schedules matching the given options
+func (c *Client) GetStorageSnapshotScheduleListWithOptions(id string, opts ListOptions) ([]StorageSnapshotSchedule, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	schedules, err := c.GetStorageSnapshotScheduleList(id)
+	var filtered []StorageSnapshotSchedule
+	for _, schedule := range schedules {
+		if match(schedule.listEntry()) {
+			filtered = append(filtered, schedule)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetStorageSnapshotScheduleMap gets the storage snapshot schedules keyed by their UUID
+func (c *Client) GetStorageSnapshotScheduleMap(id string) (map[string]StorageSnapshotSchedule, error) {
+	schedules, err := c.GetStorageSnapshotScheduleList(id)
+	scheduleMap := make(map[string]StorageSnapshotSchedule, len(schedules))
+	for _, schedule := range schedules {
+		scheduleMap[schedule.Properties.ObjectUUID] = schedule
+	}
+	return scheduleMap, err
+}
+
+//listEntry returns the attributes list options are applied to
+func (s StorageSnapshotSchedule) listEntry() listEntry {
+	return listEntry{
+		uuid:       s.Properties.ObjectUUID,
+		name:       s.Properties.Name,
+		createTime: s.Properties.CreateTime,
+		status:     s.Properties.Status,
+		labels:     s.Properties.Labels,
+	}
+}
+
 //GetStorageSnapshotSchedule gets a specific storage snapshot scheduler based on a given storage's id and scheduler's id
 func (c *Client) GetStorageSnapshotSchedule(storageID, scheduleID string) (StorageSnapshotSchedule, error) {
 	r := Request{
@@ -120,6 +163,28 @@ func (c *Client) UpdateStorageSnapshotSchedule(storageID, scheduleID string,
 	return r.execute(*c, nil)
 }
 
+//AddStorageSnapshotScheduleLabels adds labels to a storage snapshot schedule. Labels with a key the storage snapshot schedule already has replace the existing ones
+func (c *Client) AddStorageSnapshotScheduleLabels(storageID, scheduleID string, labels ...string) error {
+	schedule, err := c.GetStorageSnapshotSchedule(storageID, scheduleID)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiStorageBase, storageID, "snapshot_schedules", scheduleID), schedule.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdateStorageSnapshotSchedule(storageID, scheduleID, StorageSnapshotScheduleUpdateRequest{Labels: labels})
+	})
+}
+
+//RemoveStorageSnapshotScheduleLabels removes the labels with the given keys from a storage snapshot schedule
+func (c *Client) RemoveStorageSnapshotScheduleLabels(storageID, scheduleID string, keys ...string) error {
+	schedule, err := c.GetStorageSnapshotSchedule(storageID, scheduleID)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiStorageBase, storageID, "snapshot_schedules", scheduleID), schedule.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdateStorageSnapshotSchedule(storageID, scheduleID, StorageSnapshotScheduleUpdateRequest{Labels: labels})
+	})
+}
+
 //DeleteStorageSnapshotSchedule deletes specific Storage's snapshot scheduler based on a given storage's id and scheduler's id
 func (c *Client) DeleteStorageSnapshotSchedule(storageID, scheduleID string) error {
 	r := Request{
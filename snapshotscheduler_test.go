@@ -26,6 +26,47 @@ func TestClient_GetStorageSnapshotScheduleList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockStorageSnapshotSchedule()), fmt.Sprintf("%v", res))
 }
 
+func TestClient_GetStorageSnapshotScheduleListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID, "snapshot_schedules")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareStorageSnapshotScheduleListHTTPGet())
+	})
+	res, err := client.GetStorageSnapshotScheduleListWithOptions(dummyUUID, ListOptions{NamePattern: "*", LabelSelector: "!deprecated", SortBy: SortByCreateTime})
+	if err != nil {
+		t.Errorf("GetStorageSnapshotScheduleListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetStorageSnapshotScheduleListWithOptions(dummyUUID, ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetStorageSnapshotScheduleListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetStorageSnapshotScheduleListWithOptions(dummyUUID, ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetStorageSnapshotScheduleMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID, "snapshot_schedules")
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareStorageSnapshotScheduleListHTTPGet())
+	})
+	res, err := client.GetStorageSnapshotScheduleMap(dummyUUID)
+	if err != nil {
+		t.Errorf("GetStorageSnapshotScheduleMap returned an error %v", err)
+	}
+	mock := getMockStorageSnapshotSchedule()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
 func TestClient_GetStorageSnapshotSchedule(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -90,6 +131,30 @@ func TestClient_UpdateStorageSnapshotSchedule(t *testing.T) {
 	}
 }
 
+func TestClient_AddStorageSnapshotScheduleLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID, "snapshot_schedules", dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareStorageSnapshotScheduleHTTPGet())
+	err := client.AddStorageSnapshotScheduleLabels(dummyUUID, dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddStorageSnapshotScheduleLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"label", "env=prod"}}, *updates)
+}
+
+func TestClient_RemoveStorageSnapshotScheduleLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID, "snapshot_schedules", dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareStorageSnapshotScheduleHTTPGet())
+	err := client.RemoveStorageSnapshotScheduleLabels(dummyUUID, dummyUUID, "label")
+	if err != nil {
+		t.Errorf("RemoveStorageSnapshotScheduleLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{}}, *updates)
+}
+
 func TestClient_DeleteStorageSnapshotSchedule(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -41,28 +41,14 @@ type SshkeyUpdateRequest struct {
 	Labels []string `json:"labels,omitempty"`
 }
 
-//SshkeyEventList JSON struct of a list of a SSH-key's events
-type SshkeyEventList struct {
-	List []SshkeyEventProperties `json:"events"`
-}
+//SshkeyEventList the list of events of a SSH-key, it's the same type as EventList
+type SshkeyEventList = EventList
 
-//SshkeyEvent JSON struct of an event of a SSH-key
-type SshkeyEvent struct {
-	Properties SshkeyEventProperties `json:"event"`
-}
+//SshkeyEvent a single event of a SSH-key, it's the same type as Event
+type SshkeyEvent = Event
 
-//SshkeyEventProperties JSON struct of properties of an event of a SSH-key
-type SshkeyEventProperties struct {
-	ObjectType    string `json:"object_type"`
-	RequestUUID   string `json:"request_uuid"`
-	ObjectUUID    string `json:"object_uuid"`
-	Activity      string `json:"activity"`
-	RequestType   string `json:"request_type"`
-	RequestStatus string `json:"request_status"`
-	Change        string `json:"change"`
-	Timestamp     string `json:"timestamp"`
-	UserUUID      string `json:"user_uuid"`
-}
+//SshkeyEventProperties the properties of an event of a SSH-key, it's the same type as EventProperties
+type SshkeyEventProperties = EventProperties
 
 //GetSshkey gets a ssh key
 func (c *Client) GetSshkey(id string) (Sshkey, error) {
@@ -88,9 +74,68 @@ func (c *Client) GetSshkeyList() ([]Sshkey, error) {
 	for _, properties := range response.List {
 		sshKeys = append(sshKeys, Sshkey{Properties: properties})
 	}
+	sortList(sshKeys, SortByName, func(i int) listEntry {
+		return sshKeys[i].listEntry()
+	})
 	return sshKeys, err
 }
 
+//GetSshkeyListWithOptions gets a list of SSH-keys matching the given options
+func (c *Client) GetSshkeyListWithOptions(opts ListOptions) ([]Sshkey, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	sshKeys, err := c.GetSshkeyList()
+	var filtered []Sshkey
+	for _, sshkey := range sshKeys {
+		if match(sshkey.listEntry()) {
+			filtered = append(filtered, sshkey)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetSshkeyMap gets the SSH-keys keyed by their UUID
+func (c *Client) GetSshkeyMap() (map[string]Sshkey, error) {
+	sshKeys, err := c.GetSshkeyList()
+	sshkeyMap := make(map[string]Sshkey, len(sshKeys))
+	for _, sshkey := range sshKeys {
+		sshkeyMap[sshkey.Properties.ObjectUUID] = sshkey
+	}
+	return sshkeyMap, err
+}
+
+//GetSshkeyByName gets a SSH-key by its name. If no SSH-key has the name an ObjectNotFoundError is returned,
+//if several have it an AmbiguousNameError.
+func (c *Client) GetSshkeyByName(name string) (Sshkey, error) {
+	sshKeys, err := c.GetSshkeyList()
+	if err != nil {
+		return Sshkey{}, err
+	}
+	i, err := findByName("SSH-key", name, len(sshKeys), func(i int) listEntry {
+		return sshKeys[i].listEntry()
+	})
+	if err != nil {
+		return Sshkey{}, err
+	}
+	return sshKeys[i], nil
+}
+
+//listEntry returns the attributes list options are applied to
+func (s Sshkey) listEntry() listEntry {
+	return listEntry{
+		uuid:       s.Properties.ObjectUUID,
+		name:       s.Properties.Name,
+		createTime: s.Properties.CreateTime,
+		status:     s.Properties.Status,
+		labels:     s.Properties.Labels,
+	}
+}
+
 //CreateSshkey creates a ssh key
 func (c *Client) CreateSshkey(body SshkeyCreateRequest) (CreateResponse, error) {
 	r := Request{
@@ -126,6 +171,28 @@ func (c *Client) UpdateSshkey(id string, body SshkeyUpdateRequest) error {
 	return r.execute(*c, nil)
 }
 
+//AddSshkeyLabels adds labels to a SSH-key. Labels with a key the SSH-key already has replace the existing ones
+func (c *Client) AddSshkeyLabels(id string, labels ...string) error {
+	sshkey, err := c.GetSshkey(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiSshkeyBase, id), sshkey.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdateSshkey(id, SshkeyUpdateRequest{Labels: labels})
+	})
+}
+
+//RemoveSshkeyLabels removes the labels with the given keys from a SSH-key
+func (c *Client) RemoveSshkeyLabels(id string, keys ...string) error {
+	sshkey, err := c.GetSshkey(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiSshkeyBase, id), sshkey.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdateSshkey(id, SshkeyUpdateRequest{Labels: labels})
+	})
+}
+
 //GetSshkeyEventList gets a ssh key's events
 func (c *Client) GetSshkeyEventList(id string) ([]SshkeyEvent, error) {
 	r := Request{
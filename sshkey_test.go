@@ -25,6 +25,66 @@ func TestClient_GetSshkeyList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockSshkey()), fmt.Sprintf("%v", res))
 }
 
+func TestClient_GetSshkeyListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiSshkeyBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareSshkeyListHTTPGet())
+	})
+	res, err := client.GetSshkeyListWithOptions(ListOptions{NamePattern: "*", LabelSelector: "!deprecated", SortBy: SortByCreateTime})
+	if err != nil {
+		t.Errorf("GetSshkeyListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetSshkeyListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetSshkeyListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetSshkeyListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetSshkeyMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiSshkeyBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareSshkeyListHTTPGet())
+	})
+	res, err := client.GetSshkeyMap()
+	if err != nil {
+		t.Errorf("GetSshkeyMap returned an error %v", err)
+	}
+	mock := getMockSshkey()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
+func TestClient_GetSshkeyByName(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiSshkeyBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareSshkeyListHTTPGet())
+	})
+	mock := getMockSshkey()
+	res, err := client.GetSshkeyByName(mock.Properties.Name)
+	if err != nil {
+		t.Errorf("GetSshkeyByName returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res))
+
+	_, err = client.GetSshkeyByName("unknown")
+	assert.IsType(t, ObjectNotFoundError{}, err)
+}
+
 func TestClient_GetSshkey(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -83,6 +143,30 @@ func TestClient_UpdateSshkey(t *testing.T) {
 	}
 }
 
+func TestClient_AddSshkeyLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiSshkeyBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareSshkeyHTTPGet())
+	err := client.AddSshkeyLabels(dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddSshkeyLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"label", "env=prod"}}, *updates)
+}
+
+func TestClient_RemoveSshkeyLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiSshkeyBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareSshkeyHTTPGet())
+	err := client.RemoveSshkeyLabels(dummyUUID, "label")
+	if err != nil {
+		t.Errorf("RemoveSshkeyLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{}}, *updates)
+}
+
 func TestClient_DeleteSshkey(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -105,28 +105,14 @@ type StorageUpdateRequest struct {
 	Capacity int      `json:"capacity,omitempty"`
 }
 
-//StorageEventList JSON struct of a list of a storage's events
-type StorageEventList struct {
-	List []StorageEventProperties `json:"events"`
-}
+//StorageEventList the list of events of a storage, it's the same type as EventList
+type StorageEventList = EventList
 
-//StorageEvent JSON struct of an event of a storage
-type StorageEvent struct {
-	Properties StorageEventProperties `json:"event"`
-}
+//StorageEvent a single event of a storage, it's the same type as Event
+type StorageEvent = Event
 
-//StorageEventProperties JSON struct of properties of an event of a storage
-type StorageEventProperties struct {
-	ObjectType    string `json:"object_type"`
-	RequestUUID   string `json:"request_uuid"`
-	ObjectUUID    string `json:"object_uuid"`
-	Activity      string `json:"activity"`
-	RequestType   string `json:"request_type"`
-	RequestStatus string `json:"request_status"`
-	Change        string `json:"change"`
-	Timestamp     string `json:"timestamp"`
-	UserUUID      string `json:"user_uuid"`
-}
+//StorageEventProperties the properties of an event of a storage, it's the same type as EventProperties
+type StorageEventProperties = EventProperties
 
 //GetStorage get a storage
 func (c *Client) GetStorage(id string) (Storage, error) {
@@ -153,9 +139,69 @@ func (c *Client) GetStorageList() ([]Storage, error) {
 			Properties: properties,
 		})
 	}
+	sortList(storages, SortByName, func(i int) listEntry {
+		return storages[i].listEntry()
+	})
 	return storages, err
 }
 
+//GetStorageListWithOptions gets a list of available storages matching the given options
+func (c *Client) GetStorageListWithOptions(opts ListOptions) ([]Storage, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	storages, err := c.GetStorageList()
+	var filtered []Storage
+	for _, storage := range storages {
+		if match(storage.listEntry()) {
+			filtered = append(filtered, storage)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetStorageMap gets the storages keyed by their UUID
+func (c *Client) GetStorageMap() (map[string]Storage, error) {
+	storages, err := c.GetStorageList()
+	storageMap := make(map[string]Storage, len(storages))
+	for _, storage := range storages {
+		storageMap[storage.Properties.ObjectUUID] = storage
+	}
+	return storageMap, err
+}
+
+//GetStorageByName gets a storage by its name. If no storage has the name an ObjectNotFoundError is returned,
+//if several have it an AmbiguousNameError.
+func (c *Client) GetStorageByName(name string) (Storage, error) {
+	storages, err := c.GetStorageList()
+	if err != nil {
+		return Storage{}, err
+	}
+	i, err := findByName("storage", name, len(storages), func(i int) listEntry {
+		return storages[i].listEntry()
+	})
+	if err != nil {
+		return Storage{}, err
+	}
+	return storages[i], nil
+}
+
+//listEntry returns the attributes list options are applied to
+func (s Storage) listEntry() listEntry {
+	return listEntry{
+		uuid:         s.Properties.ObjectUUID,
+		name:         s.Properties.Name,
+		createTime:   s.Properties.CreateTime,
+		locationUUID: s.Properties.LocationUUID,
+		status:       s.Properties.Status,
+		labels:       s.Properties.Labels,
+	}
+}
+
 //CreateStorage create a storage
 func (c *Client) CreateStorage(body StorageCreateRequest) (CreateResponse, error) {
 	r := Request{
@@ -172,6 +218,21 @@ func (c *Client) CreateStorage(body StorageCreateRequest) (CreateResponse, error
 	return response, err
 }
 
+//CloneStorage creates a copy of a storage, the copy gets a name of its own
+func (c *Client) CloneStorage(id string) (CreateResponse, error) {
+	r := Request{
+		uri:    path.Join(apiStorageBase, id, "clone"),
+		method: http.MethodPost,
+	}
+	var response CreateResponse
+	err := r.execute(*c, &response)
+	if err != nil {
+		return CreateResponse{}, err
+	}
+	err = c.WaitForRequestCompletion(response.RequestUUID)
+	return response, err
+}
+
 //DeleteStorage delete a storage
 func (c *Client) DeleteStorage(id string) error {
 	r := Request{
@@ -191,6 +252,28 @@ func (c *Client) UpdateStorage(id string, body StorageUpdateRequest) error {
 	return r.execute(*c, nil)
 }
 
+//AddStorageLabels adds labels to a storage. Labels with a key the storage already has replace the existing ones
+func (c *Client) AddStorageLabels(id string, labels ...string) error {
+	storage, err := c.GetStorage(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiStorageBase, id), storage.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdateStorage(id, StorageUpdateRequest{Labels: labels})
+	})
+}
+
+//RemoveStorageLabels removes the labels with the given keys from a storage
+func (c *Client) RemoveStorageLabels(id string, keys ...string) error {
+	storage, err := c.GetStorage(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiStorageBase, id), storage.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdateStorage(id, StorageUpdateRequest{Labels: labels})
+	})
+}
+
 //GetStorageEventList get list of a storage's events
 func (c *Client) GetStorageEventList(id string) ([]StorageEvent, error) {
 	r := Request{
@@ -25,6 +25,66 @@ func TestClient_GetStorageList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockStorage()), fmt.Sprintf("%v", response))
 }
 
+func TestClient_GetStorageListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiStorageBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareStorageListHTTPGet())
+	})
+	res, err := client.GetStorageListWithOptions(ListOptions{NamePattern: "*", LabelSelector: "!deprecated"})
+	if err != nil {
+		t.Errorf("GetStorageListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetStorageListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetStorageListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetStorageListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetStorageMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiStorageBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareStorageListHTTPGet())
+	})
+	res, err := client.GetStorageMap()
+	if err != nil {
+		t.Errorf("GetStorageMap returned an error %v", err)
+	}
+	mock := getMockStorage()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
+func TestClient_GetStorageByName(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiStorageBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareStorageListHTTPGet())
+	})
+	mock := getMockStorage()
+	res, err := client.GetStorageByName(mock.Properties.Name)
+	if err != nil {
+		t.Errorf("GetStorageByName returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res))
+
+	_, err = client.GetStorageByName("unknown")
+	assert.IsType(t, ObjectNotFoundError{}, err)
+}
+
 func TestClient_GetStorage(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -73,6 +133,27 @@ func TestClient_CreateStorage(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("%v", getMockStorageCreateResponse()), fmt.Sprintf("%v", res))
 }
 
+func TestClient_CloneStorage(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID, "clone")
+	mux.HandleFunc(uri, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		fmt.Fprintf(w, prepareStorageCreateResponse())
+	})
+
+	httpResponse := fmt.Sprintf(`{"%s": {"status":"done"}}`, dummyRequestUUID)
+	mux.HandleFunc("/requests/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, httpResponse)
+	})
+
+	res, err := client.CloneStorage(dummyUUID)
+	if err != nil {
+		t.Errorf("CloneStorage returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("%v", getMockStorageCreateResponse()), fmt.Sprintf("%v", res))
+}
+
 func TestClient_UpdateStorage(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -91,6 +172,30 @@ func TestClient_UpdateStorage(t *testing.T) {
 	}
 }
 
+func TestClient_AddStorageLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareStorageHTTPGet())
+	err := client.AddStorageLabels(dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddStorageLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"label", "env=prod"}}, *updates)
+}
+
+func TestClient_RemoveStorageLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiStorageBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareStorageHTTPGet())
+	err := client.RemoveStorageLabels(dummyUUID, "label")
+	if err != nil {
+		t.Errorf("RemoveStorageLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{}}, *updates)
+}
+
 func TestClient_DeleteStorage(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
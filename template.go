@@ -1,7 +1,6 @@
 package gsclient
 
 import (
-	"fmt"
 	"net/http"
 	"path"
 )
@@ -39,28 +38,14 @@ type TemplateProperties struct {
 	Labels           []string `json:"labels"`
 }
 
-//TemplateEventList JSON struct of a list of a template's events
-type TemplateEventList struct {
-	List []TemplateEventProperties `json:"events"`
-}
+//TemplateEventList the list of events of a template, it's the same type as EventList
+type TemplateEventList = EventList
 
-//TemplateEvent JSON struct of an event of a template
-type TemplateEvent struct {
-	Properties TemplateEventProperties `json:"event"`
-}
+//TemplateEvent a single event of a template, it's the same type as Event
+type TemplateEvent = Event
 
-//TemplateEventProperties JSON struct of properties of an event of a template
-type TemplateEventProperties struct {
-	ObjectType    string `json:"object_type"`
-	RequestUUID   string `json:"request_uuid"`
-	ObjectUUID    string `json:"object_uuid"`
-	Activity      string `json:"activity"`
-	RequestType   string `json:"request_type"`
-	RequestStatus string `json:"request_status"`
-	Change        string `json:"change"`
-	Timestamp     string `json:"timestamp"`
-	UserUUID      string `json:"user_uuid"`
-}
+//TemplateEventProperties the properties of an event of a template, it's the same type as EventProperties
+type TemplateEventProperties = EventProperties
 
 //TemplateCreateRequest JSON struct of a request for creating a template
 type TemplateCreateRequest struct {
@@ -100,21 +85,67 @@ func (c *Client) GetTemplateList() ([]Template, error) {
 			Properties: properties,
 		})
 	}
+	sortList(templates, SortByName, func(i int) listEntry {
+		return templates[i].listEntry()
+	})
 	return templates, err
 }
 
-//GetTemplateByName gets a template by its name
+//GetTemplateListWithOptions gets a list of available templates matching the given options
+func (c *Client) GetTemplateListWithOptions(opts ListOptions) ([]Template, error) {
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+	templates, err := c.GetTemplateList()
+	var filtered []Template
+	for _, template := range templates {
+		if match(template.listEntry()) {
+			filtered = append(filtered, template)
+		}
+	}
+	sortList(filtered, opts.SortBy, func(i int) listEntry {
+		return filtered[i].listEntry()
+	})
+	return filtered, err
+}
+
+//GetTemplateMap gets the templates keyed by their UUID
+func (c *Client) GetTemplateMap() (map[string]Template, error) {
+	templates, err := c.GetTemplateList()
+	templateMap := make(map[string]Template, len(templates))
+	for _, template := range templates {
+		templateMap[template.Properties.ObjectUUID] = template
+	}
+	return templateMap, err
+}
+
+//listEntry returns the attributes list options are applied to
+func (t Template) listEntry() listEntry {
+	return listEntry{
+		uuid:         t.Properties.ObjectUUID,
+		name:         t.Properties.Name,
+		createTime:   t.Properties.CreateTime,
+		locationUUID: t.Properties.LocationUUID,
+		status:       t.Properties.Status,
+		labels:       t.Properties.Labels,
+	}
+}
+
+//GetTemplateByName gets a template by its name. If no template has the name an ObjectNotFoundError is returned,
+//if several have it an AmbiguousNameError.
 func (c *Client) GetTemplateByName(name string) (Template, error) {
 	templates, err := c.GetTemplateList()
 	if err != nil {
 		return Template{}, err
 	}
-	for _, template := range templates {
-		if template.Properties.Name == name {
-			return Template{Properties: template.Properties}, nil
-		}
+	i, err := findByName("template", name, len(templates), func(i int) listEntry {
+		return templates[i].listEntry()
+	})
+	if err != nil {
+		return Template{}, err
 	}
-	return Template{}, fmt.Errorf("Template %v not found", name)
+	return templates[i], nil
 }
 
 //CreateTemplate creates a template
@@ -139,6 +170,28 @@ func (c *Client) UpdateTemplate(id string, body TemplateUpdateRequest) error {
 	return r.execute(*c, nil)
 }
 
+//AddTemplateLabels adds labels to a template. Labels with a key the template already has replace the existing ones
+func (c *Client) AddTemplateLabels(id string, labels ...string) error {
+	template, err := c.GetTemplate(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiTemplateBase, id), template.Properties.Labels, labels, nil, func(labels []string) error {
+		return c.UpdateTemplate(id, TemplateUpdateRequest{Labels: labels})
+	})
+}
+
+//RemoveTemplateLabels removes the labels with the given keys from a template
+func (c *Client) RemoveTemplateLabels(id string, keys ...string) error {
+	template, err := c.GetTemplate(id)
+	if err != nil {
+		return err
+	}
+	return c.modifyLabels(path.Join(apiTemplateBase, id), template.Properties.Labels, nil, keys, func(labels []string) error {
+		return c.UpdateTemplate(id, TemplateUpdateRequest{Labels: labels})
+	})
+}
+
 //DeleteTemplate deletes a template
 func (c *Client) DeleteTemplate(id string) error {
 	r := Request{
@@ -25,6 +25,47 @@ func TestClient_GetTemplateList(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("[%v]", getMockTemplate()), fmt.Sprintf("%v", response))
 }
 
+func TestClient_GetTemplateListWithOptions(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiTemplateBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareTemplateListHTTPGet())
+	})
+	res, err := client.GetTemplateListWithOptions(ListOptions{NamePattern: "*", LabelSelector: "!deprecated"})
+	if err != nil {
+		t.Errorf("GetTemplateListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	res, err = client.GetTemplateListWithOptions(ListOptions{LabelSelector: "env=prod"})
+	if err != nil {
+		t.Errorf("GetTemplateListWithOptions returned an error %v", err)
+	}
+	assert.Equal(t, 0, len(res))
+
+	_, err = client.GetTemplateListWithOptions(ListOptions{LabelSelector: "=prod"})
+	assert.Error(t, err)
+}
+
+func TestClient_GetTemplateMap(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiTemplateBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		fmt.Fprint(writer, prepareTemplateListHTTPGet())
+	})
+	res, err := client.GetTemplateMap()
+	if err != nil {
+		t.Errorf("GetTemplateMap returned an error %v", err)
+	}
+	mock := getMockTemplate()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, fmt.Sprintf("%v", mock), fmt.Sprintf("%v", res[mock.Properties.ObjectUUID]))
+}
+
 func TestClient_GetTemplate(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
@@ -53,6 +94,9 @@ func TestClient_GetTemplateByName(t *testing.T) {
 		t.Errorf("GetTemplateByName returned an error %v", err)
 	}
 	assert.Equal(t, fmt.Sprintf("%v", getMockTemplate()), fmt.Sprintf("%v", response))
+
+	_, err = client.GetTemplateByName("unknown")
+	assert.Equal(t, ObjectNotFoundError{Kind: "template", Name: "unknown"}, err)
 }
 
 func TestClient_CreateTemplate(t *testing.T) {
@@ -97,6 +141,30 @@ func TestClient_UpdateTemplate(t *testing.T) {
 	}
 }
 
+func TestClient_AddTemplateLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiTemplateBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareTemplateHTTPGet())
+	err := client.AddTemplateLabels(dummyUUID, "env=prod")
+	if err != nil {
+		t.Errorf("AddTemplateLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{"label", "env=prod"}}, *updates)
+}
+
+func TestClient_RemoveTemplateLabels(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := path.Join(apiTemplateBase, dummyUUID)
+	updates := handleLabelsUpdate(t, mux, uri, prepareTemplateHTTPGet())
+	err := client.RemoveTemplateLabels(dummyUUID, "label")
+	if err != nil {
+		t.Errorf("RemoveTemplateLabels returned an error %v", err)
+	}
+	assert.Equal(t, [][]string{{}}, *updates)
+}
+
 func TestClient_DeleteTemplate(t *testing.T) {
 	server, client, mux := setupTestClient()
 	defer server.Close()
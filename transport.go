@@ -0,0 +1,91 @@
+package gsclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"runtime"
+	"time"
+)
+
+//Version is the version of gsclient-go
+const Version = "0.2.0"
+
+//HTTPClientOptions options for creating an HTTP client with NewHTTPClient
+type HTTPClientOptions struct {
+	//Timeout of a whole request including reading the response, no timeout if 0
+	Timeout time.Duration
+	//CACertFile is a PEM bundle of CA certificates trusted in addition to the system's ones
+	CACertFile string
+	//ClientCertFile and ClientKeyFile are a PEM certificate and key used for mutual TLS
+	ClientCertFile string
+	ClientKeyFile  string
+	//ProxyURL is the URL of an HTTP proxy. If empty, HTTP_PROXY, HTTPS_PROXY and NO_PROXY are used
+	ProxyURL string
+}
+
+//NewHTTPClient creates an HTTP client for Config.HTTPClient
+func NewHTTPClient(opts HTTPClientOptions) (*http.Client, error) {
+	tlsConfig := &tls.Config{}
+
+	if opts.CACertFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		pem, err := ioutil.ReadFile(opts.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", opts.CACertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if opts.ClientCertFile != "" || opts.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.ClientCertFile, opts.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if opts.ProxyURL != "" {
+		proxyURL, err := url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	transport := &http.Transport{
+		Proxy:                 proxy,
+		TLSClientConfig:       tlsConfig,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   opts.Timeout,
+	}, nil
+}
+
+//DefaultUserAgent returns the User-Agent sent by default, e.g. "gsclient-go/0.2.0 (go1.12; linux/amd64)"
+func DefaultUserAgent() string {
+	return fmt.Sprintf("gsclient-go/%s (%s; %s/%s)", Version, runtime.Version(), runtime.GOOS, runtime.GOARCH)
+}
+
+//UserAgent returns the default User-Agent with the name of an application appended, e.g. UserAgent("my-tool/1.2")
+func UserAgent(application string) string {
+	if application == "" {
+		return DefaultUserAgent()
+	}
+	return DefaultUserAgent() + " " + application
+}
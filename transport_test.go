@@ -0,0 +1,125 @@
+package gsclient
+
+import (
+	"compress/gzip"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_UserAgent(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	client.cfg.UserAgent = UserAgent("my-tool/1.2")
+	uri := apiServerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		userAgent := request.Header.Get("User-Agent")
+		assert.True(t, strings.HasPrefix(userAgent, "gsclient-go/"+Version+" ("))
+		assert.True(t, strings.HasSuffix(userAgent, ") my-tool/1.2"))
+		fmt.Fprint(writer, prepareServerListHTTPGet())
+	})
+	_, err := client.GetServerList()
+	if err != nil {
+		t.Errorf("GetServerList returned an error %v", err)
+	}
+}
+
+func TestClient_GzipResponse(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	uri := apiServerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, "gzip", request.Header.Get("Accept-Encoding"))
+		writer.Header().Set("Content-Encoding", "gzip")
+		gzipWriter := gzip.NewWriter(writer)
+		defer gzipWriter.Close()
+		fmt.Fprint(gzipWriter, prepareServerListHTTPGet())
+	})
+	res, err := client.GetServerList()
+	if err != nil {
+		t.Errorf("GetServerList returned an error %v", err)
+	}
+	assert.Equal(t, fmt.Sprintf("[%v]", getMockServer(true)), fmt.Sprintf("%v", res))
+}
+
+func TestClient_DisableCompression(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	client.cfg.DisableCompression = true
+	client.cfg.HTTPClient = &http.Client{Transport: &http.Transport{DisableCompression: true}}
+	uri := apiServerBase
+	mux.HandleFunc(uri, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, "", request.Header.Get("Accept-Encoding"))
+		fmt.Fprint(writer, prepareServerListHTTPGet())
+	})
+	_, err := client.GetServerList()
+	if err != nil {
+		t.Errorf("GetServerList returned an error %v", err)
+	}
+}
+
+func TestNewHTTPClient_CACertFile(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, prepareServerListHTTPGet())
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "gsclient")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	caFile := filepath.Join(dir, "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := ioutil.WriteFile(caFile, caPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	httpClient, err := NewHTTPClient(HTTPClientOptions{Timeout: 5 * time.Second, CACertFile: caFile})
+	if err != nil {
+		t.Fatalf("NewHTTPClient returned an error %v", err)
+	}
+	assert.Equal(t, 5*time.Second, httpClient.Timeout)
+	config := NewConfiguration(server.URL, "uuid", "token", true)
+	config.HTTPClient = httpClient
+	res, err := NewClient(config).GetServerList()
+	if err != nil {
+		t.Errorf("GetServerList returned an error %v", err)
+	}
+	assert.Equal(t, 1, len(res))
+
+	//Without the CA bundle the server's certificate isn't trusted
+	config.HTTPClient, _ = NewHTTPClient(HTTPClientOptions{})
+	_, err = NewClient(config).GetServerList()
+	assert.Error(t, err)
+}
+
+func TestNewHTTPClient_InvalidOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gsclient")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	emptyFile := filepath.Join(dir, "empty.pem")
+	if err := ioutil.WriteFile(emptyFile, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewHTTPClient(HTTPClientOptions{CACertFile: emptyFile})
+	assert.Error(t, err)
+	_, err = NewHTTPClient(HTTPClientOptions{ClientCertFile: emptyFile, ClientKeyFile: emptyFile})
+	assert.Error(t, err)
+	_, err = NewHTTPClient(HTTPClientOptions{ProxyURL: "http://[::1"})
+	assert.Error(t, err)
+	_, err = NewHTTPClient(HTTPClientOptions{ProxyURL: "http://proxy.example.com:3128"})
+	assert.Nil(t, err)
+}
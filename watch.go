@@ -0,0 +1,260 @@
+package gsclient
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+//DefaultWatchInterval is the time between two polls of a watch if WatchOptions.Interval isn't set
+const DefaultWatchInterval = 10 * time.Second
+
+//WatchEventType type of a watch event
+type WatchEventType int
+
+//Types of watch events
+const (
+	//WatchAdded an object has been created, or it has existed when the watch has started
+	WatchAdded WatchEventType = iota
+	//WatchModified an object has been changed
+	WatchModified
+	//WatchDeleted an object has been deleted
+	WatchDeleted
+	//WatchError a poll has failed, the watch goes on with the next poll
+	WatchError
+)
+
+//String returns the name of the event type
+func (t WatchEventType) String() string {
+	switch t {
+	case WatchAdded:
+		return "Added"
+	case WatchModified:
+		return "Modified"
+	case WatchDeleted:
+		return "Deleted"
+	case WatchError:
+		return "Error"
+	}
+	return "Unknown"
+}
+
+//WatchEvent a change of a watched object. Object is the current state of the object, or the last known
+//state if the object has been deleted. Previous and ChangedFields are only set for modified objects,
+//Err only for errors.
+type WatchEvent struct {
+	Type          WatchEventType
+	Kind          ObjectKind
+	Object        Object
+	Previous      Object
+	ChangedFields []string
+	Err           error
+}
+
+//WatchOptions options of a watch
+type WatchOptions struct {
+	//Interval time between two polls, DefaultWatchInterval if zero
+	Interval time.Duration
+	//UUIDs watches only these objects by getting each of them instead of listing all objects of the kind
+	UUIDs []string
+	//Filter watches only the objects of the list matching the filter, the sort key is ignored
+	Filter ListOptions
+	//IgnoreExisting doesn't send Added events for the objects existing when the watch starts
+	IgnoreExisting bool
+}
+
+//Watch polls the objects of a kind and sends an event on the returned channel for every object that has
+//been added, modified or deleted since the previous poll. An object is modified if any of its properties
+//has changed, which includes its ChangeTime, apart from its usage and price which change all the time;
+//the names of the changed properties are in the event.
+//The events of a poll are sent ordered by UUID.
+//
+//The watch runs until ctx is cancelled, then the channel is closed. Events are sent unbuffered, a poll
+//doesn't start before the events of the previous poll have been received.
+func (c *Client) Watch(ctx context.Context, kind ObjectKind, opts WatchOptions) (<-chan WatchEvent, error) {
+	k, err := lookupObjectKind(kind)
+	if err != nil {
+		return nil, err
+	}
+	match, err := opts.Filter.matcher()
+	if err != nil {
+		return nil, err
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	w := &watcher{
+		client:         c,
+		kind:           kind,
+		def:            k,
+		uuids:          uniqueIDs(opts.UUIDs),
+		match:          match,
+		ignoreExisting: opts.IgnoreExisting,
+	}
+	events := make(chan WatchEvent)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			for _, event := range w.poll() {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+//watcher the state of a watch between two polls
+type watcher struct {
+	client         *Client
+	kind           ObjectKind
+	def            objectKind
+	uuids          []string
+	match          func(listEntry) bool
+	ignoreExisting bool
+	//snapshot the objects of the previous poll, nil until the objects have been fetched once
+	snapshot map[string]Object
+}
+
+//poll fetches the objects and returns the events since the previous poll. If existing objects are ignored,
+//the first snapshot is only recorded and just errors are returned, also if previous polls have failed.
+func (w *watcher) poll() []WatchEvent {
+	quiet := w.ignoreExisting && w.snapshot == nil
+	current, errs := w.fetch()
+	var events []WatchEvent
+	for _, err := range errs {
+		events = append(events, WatchEvent{Type: WatchError, Kind: w.kind, Err: err})
+	}
+	if current == nil {
+		return events
+	}
+
+	ids := make([]string, 0, len(current)+len(w.snapshot))
+	for id := range current {
+		ids = append(ids, id)
+	}
+	for id := range w.snapshot {
+		if _, ok := current[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		previous, existed := w.snapshot[id]
+		object, exists := current[id]
+		switch {
+		case !existed:
+			events = append(events, WatchEvent{Type: WatchAdded, Kind: w.kind, Object: object})
+		case !exists:
+			events = append(events, WatchEvent{Type: WatchDeleted, Kind: w.kind, Object: previous})
+		default:
+			if fields := changedFields(previous, object); len(fields) > 0 {
+				events = append(events, WatchEvent{
+					Type:          WatchModified,
+					Kind:          w.kind,
+					Object:        object,
+					Previous:      previous,
+					ChangedFields: fields,
+				})
+			}
+		}
+	}
+	w.snapshot = current
+	if quiet {
+		return events[:len(errs)]
+	}
+	return events
+}
+
+//fetch gets the watched objects keyed by UUID. If the objects cannot be listed, the map is nil.
+//Objects which cannot be fetched for another reason than being deleted keep their previous state.
+func (w *watcher) fetch() (map[string]Object, []error) {
+	current := make(map[string]Object)
+	if len(w.uuids) == 0 {
+		list, err := w.def.list(w.client)
+		if err != nil {
+			return nil, []error{err}
+		}
+		for _, object := range list {
+			if w.matches(object) {
+				current[object.UUID()] = object
+			}
+		}
+		return current, nil
+	}
+
+	objects := make([]Object, len(w.uuids))
+	errs := w.client.forEachConcurrently(len(w.uuids), func(i int) (err error) {
+		objects[i], err = w.def.get(w.client, w.uuids[i])
+		return err
+	})
+	var failed []error
+	for i, id := range w.uuids {
+		err := errs[i]
+		if err == nil {
+			if w.matches(objects[i]) {
+				current[id] = objects[i]
+			}
+			continue
+		}
+		if requestError, ok := err.(RequestError); ok && requestError.StatusCode == http.StatusNotFound {
+			continue
+		}
+		failed = append(failed, BulkError{id: err})
+		if previous, ok := w.snapshot[id]; ok {
+			current[id] = previous
+		}
+	}
+	return current, failed
+}
+
+//matches reports whether an object passes the filter of the watch
+func (w *watcher) matches(object Object) bool {
+	entry, ok := object.(interface {
+		listEntry() listEntry
+	})
+	return !ok || w.match(entry.listEntry())
+}
+
+//isBillingField reports whether a property holds the usage or price of an object, which changes all the time
+//and doesn't make an object modified
+func isBillingField(name string) bool {
+	return name == "CurrentPrice" || strings.HasPrefix(name, "UsageInMinutes")
+}
+
+//changedFields returns the names of the properties which differ between two states of an object,
+//the usage and price of the object are ignored
+func changedFields(previous, current Object) []string {
+	before := reflect.ValueOf(previous).FieldByName("Properties")
+	after := reflect.ValueOf(current).FieldByName("Properties")
+	if !before.IsValid() || !after.IsValid() || before.Type() != after.Type() {
+		if reflect.DeepEqual(previous, current) {
+			return nil
+		}
+		return []string{"Properties"}
+	}
+	var fields []string
+	for i := 0; i < before.NumField(); i++ {
+		if isBillingField(before.Type().Field(i).Name) {
+			continue
+		}
+		if !reflect.DeepEqual(before.Field(i).Interface(), after.Field(i).Interface()) {
+			fields = append(fields, before.Type().Field(i).Name)
+		}
+	}
+	return fields
+}
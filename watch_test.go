@@ -0,0 +1,219 @@
+package gsclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//receiveWatchEvents receives n events or fails the test after a timeout
+func receiveWatchEvents(t *testing.T, events <-chan WatchEvent, n int) []WatchEvent {
+	var received []WatchEvent
+	for len(received) < n {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatalf("watch channel closed after %d events", len(received))
+			}
+			received = append(received, event)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout after %d events", len(received))
+		}
+	}
+	return received
+}
+
+func TestClient_Watch(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	responses := []string{
+		`{"servers": {"a": {"object_uuid": "a", "name": "web", "power": true}, "b": {"object_uuid": "b", "name": "db"}}}`,
+		`{"servers": {"a": {"object_uuid": "a", "name": "web", "power": false, "change_time": "later"}, "c": {"object_uuid": "c", "name": "mail"}}}`,
+	}
+	var mu sync.Mutex
+	polls := 0
+	mux.HandleFunc(apiServerBase, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		mu.Lock()
+		defer mu.Unlock()
+		if polls == 2 {
+			writer.WriteHeader(http.StatusInternalServerError)
+			polls++
+			return
+		}
+		fmt.Fprint(writer, responses[polls%2])
+		polls++
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := client.Watch(ctx, KindServer, WatchOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Watch returned an error %v", err)
+	}
+	received := receiveWatchEvents(t, events, 6)
+	cancel()
+
+	assert.Equal(t, WatchAdded, received[0].Type)
+	assert.Equal(t, "a", received[0].Object.UUID())
+	assert.Equal(t, WatchAdded, received[1].Type)
+	assert.Equal(t, "b", received[1].Object.UUID())
+
+	assert.Equal(t, WatchModified, received[2].Type)
+	assert.Equal(t, KindServer, received[2].Kind)
+	assert.Equal(t, []string{"Power", "ChangeTime"}, received[2].ChangedFields)
+	assert.True(t, received[2].Previous.(Server).Properties.Power)
+	assert.False(t, received[2].Object.(Server).Properties.Power)
+	assert.Equal(t, WatchDeleted, received[3].Type)
+	assert.Equal(t, "db", received[3].Object.Name())
+	assert.Equal(t, WatchAdded, received[4].Type)
+	assert.Equal(t, "c", received[4].Object.UUID())
+
+	assert.Equal(t, WatchError, received[5].Type)
+	assert.Error(t, received[5].Err)
+
+	//The channel is closed once the context is cancelled
+	for range events {
+	}
+}
+
+func TestClient_WatchUUIDs(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	var mu sync.Mutex
+	deleted := false
+	mux.HandleFunc(path.Join(apiStorageBase, dummyUUID), func(writer http.ResponseWriter, request *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if deleted {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(writer, prepareStorageHTTPGet())
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := client.Watch(ctx, KindStorage, WatchOptions{Interval: time.Millisecond, UUIDs: []string{dummyUUID}, IgnoreExisting: true})
+	if err != nil {
+		t.Fatalf("Watch returned an error %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	mu.Lock()
+	deleted = true
+	mu.Unlock()
+	received := receiveWatchEvents(t, events, 1)
+	assert.Equal(t, WatchDeleted, received[0].Type)
+	assert.Equal(t, dummyUUID, received[0].Object.UUID())
+}
+
+func TestClient_WatchIgnoreExistingAfterError(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	responses := []string{
+		`{"servers": {"a": {"object_uuid": "a", "name": "web"}}}`,
+		`{"servers": {"a": {"object_uuid": "a", "name": "web"}, "b": {"object_uuid": "b", "name": "db"}}}`,
+	}
+	var mu sync.Mutex
+	polls := 0
+	mux.HandleFunc(apiServerBase, func(writer http.ResponseWriter, request *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		polls++
+		switch {
+		case polls == 1:
+			writer.WriteHeader(http.StatusInternalServerError)
+		case polls == 2:
+			fmt.Fprint(writer, responses[0])
+		default:
+			fmt.Fprint(writer, responses[1])
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := client.Watch(ctx, KindServer, WatchOptions{Interval: time.Millisecond, IgnoreExisting: true})
+	if err != nil {
+		t.Fatalf("Watch returned an error %v", err)
+	}
+	//The objects of the first successful poll are existing ones, although the first poll has failed
+	received := receiveWatchEvents(t, events, 2)
+	assert.Equal(t, WatchError, received[0].Type)
+	assert.Equal(t, WatchAdded, received[1].Type)
+	assert.Equal(t, "b", received[1].Object.UUID())
+}
+
+func TestClient_WatchErrors(t *testing.T) {
+	server, client, _ := setupTestClient()
+	defer server.Close()
+	_, err := client.Watch(context.Background(), "unknown", WatchOptions{})
+	assert.Error(t, err)
+	_, err = client.Watch(context.Background(), KindServer, WatchOptions{Filter: ListOptions{NamePattern: "["}})
+	assert.Error(t, err)
+}
+
+func TestWatcher_poll(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	mux.HandleFunc(apiServerBase, func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"servers": {"a": {"object_uuid": "a", "name": "web"}, "b": {"object_uuid": "b", "name": "db"}}}`)
+	})
+	match, _ := ListOptions{NamePattern: "w*"}.matcher()
+	w := &watcher{client: client, kind: KindServer, def: objectKinds[KindServer], match: match}
+	events := w.poll()
+	if assert.Equal(t, 1, len(events)) {
+		assert.Equal(t, "a", events[0].Object.UUID())
+	}
+	assert.Equal(t, 0, len(w.poll()))
+}
+
+func TestChangedFields(t *testing.T) {
+	var before Server
+	before.Properties.ObjectUUID = "a"
+	before.Properties.UsageInMinutesCores = 10
+	before.Properties.UsageInMinutesMemory = 20
+	before.Properties.CurrentPrice = 1.5
+	after := before
+	after.Properties.UsageInMinutesCores = 11
+	after.Properties.UsageInMinutesMemory = 22
+	after.Properties.CurrentPrice = 1.6
+	assert.Empty(t, changedFields(before, after))
+
+	after.Properties.Power = true
+	assert.Equal(t, []string{"Power"}, changedFields(before, after))
+
+	var storage Storage
+	changed := storage
+	changed.Properties.UsageInMinutes = 5
+	changed.Properties.CurrentPrice = 0.1
+	assert.Empty(t, changedFields(storage, changed))
+}
+
+func TestWatcher_pollIgnoresUsage(t *testing.T) {
+	server, client, mux := setupTestClient()
+	defer server.Close()
+	polls := 0
+	mux.HandleFunc(apiServerBase, func(writer http.ResponseWriter, request *http.Request) {
+		polls++
+		fmt.Fprintf(writer, `{"servers": {"a": {"object_uuid": "a", "name": "web", "power": true,
+			"usage_in_minutes_cores": %d, "usage_in_minutes_memory": %d, "current_price": %d.5}}}`, polls, 2*polls, polls)
+	})
+	match, _ := ListOptions{}.matcher()
+	w := &watcher{client: client, kind: KindServer, def: objectKinds[KindServer], match: match, ignoreExisting: true}
+	assert.Empty(t, w.poll())
+	assert.Empty(t, w.poll())
+	assert.Empty(t, w.poll())
+}
+
+func TestWatchEventType_String(t *testing.T) {
+	assert.Equal(t, "Added", WatchAdded.String())
+	assert.Equal(t, "Modified", WatchModified.String())
+	assert.Equal(t, "Deleted", WatchDeleted.String())
+	assert.Equal(t, "Error", WatchError.String())
+	assert.Equal(t, "Unknown", WatchEventType(42).String())
+}